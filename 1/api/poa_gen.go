@@ -3,4 +3,13 @@ package api
 const (
 	Create = "attorney/0.0.1/poa/create"
 	ConfirmAttorney = "attorney/0.0.1/poa/confirm-attorney"
+	SendAttorney = "attorney/0.0.1/poa/send-attorney"
+	ReturnAttorney = "attorney/0.0.1/poa/return-attorney"
+	RejectAttorney = "attorney/0.0.1/poa/reject-attorney"
+	RevokeAttorney = "attorney/0.0.1/poa/revoke-attorney"
+	DelegationChain = "attorney/0.0.1/poa/delegation-chain"
+	VerifyAuthority = "attorney/0.0.1/poa/verify-authority"
+	GetApprovals = "attorney/0.0.1/poa/approvals"
+	GetAuditTrail = "attorney/0.0.1/poa/audit-trail"
+	FindAttorneys = "attorney/0.0.1/poa/find-attorneys"
 )
@@ -0,0 +1,9 @@
+package api
+
+const (
+	GetPolicy    = "attorney/0.0.1/policy/get"
+	UpdatePolicy = "attorney/0.0.1/policy/update"
+)
+
+// GovernanceRoutes are closed to everyone unless the policy table lists them.
+var GovernanceRoutes = []string{UpdatePolicy, AddTrustAnchor, DeleteTrustAnchor, CreatePower, UpdatePower, DeletePower}
@@ -0,0 +1,9 @@
+package api
+
+const (
+	CreatePower = "attorney/0.0.1/power/create"
+	UpdatePower = "attorney/0.0.1/power/update"
+	DeletePower = "attorney/0.0.1/power/delete"
+	GetPower    = "attorney/0.0.1/power/get"
+	ListPowers  = "attorney/0.0.1/power/list"
+)
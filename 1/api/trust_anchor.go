@@ -0,0 +1,7 @@
+package api
+
+const (
+	AddTrustAnchor    = "attorney/0.0.1/trust-anchor/add"
+	DeleteTrustAnchor = "attorney/0.0.1/trust-anchor/delete"
+	ListTrustAnchors  = "attorney/0.0.1/trust-anchor/list"
+)
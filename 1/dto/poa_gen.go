@@ -13,12 +13,65 @@ type CreateRequest struct{
 type ConfirmAttorneyRequest struct{
     
     ID string `json:"id"`
+    Comment string `json:"comment,omitempty"`
+    }
+
+type SendAttorneyRequest struct{
+    
+    ID string `json:"id"`
+    Comment string `json:"comment,omitempty"`
+    }
+
+type ReturnAttorneyRequest struct{
+    
+    ID string `json:"id"`
+    Comment string `json:"comment,omitempty"`
+    }
+
+type RejectAttorneyRequest struct{
+    
+    ID string `json:"id"`
+    Comment string `json:"comment,omitempty"`
+    }
+
+type RevokeAttorneyRequest struct{
+    
+    ID string `json:"id"`
+    Reason string `json:"reason"`
+    }
+
+type DelegationChainRequest struct{
+    
+    ID string `json:"id"`
+    }
+
+type VerifyAuthorityRequest struct{
+    
+    RepresentativeINN string `json:"representative_inn"`
+    PrincipalINN string `json:"principal_inn"`
+    PowerCode string `json:"power_code"`
+    Timestamp string `json:"timestamp,omitempty"`
+    }
+
+type GetApprovalsRequest struct{
+    
+    ID string `json:"id"`
+    }
+
+type GetAuditTrailRequest struct{
+    
+    ID string `json:"id"`
+    }
+
+type FindAttorneysRequest struct{
+    
+    Request *entity.POASearchRequest `json:"request"`
     }
 
 
 type CreateResponse struct{
     
-    Result int64 `json:"result"`
+    Result string `json:"result"`
     Error string `json:"error"`
 }
 
@@ -26,11 +79,65 @@ type ConfirmAttorneyResponse struct{
     Error string `json:"error"`
 }
 
+type SendAttorneyResponse struct{
+    Error string `json:"error"`
+}
+
+type ReturnAttorneyResponse struct{
+    Error string `json:"error"`
+}
+
+type RejectAttorneyResponse struct{
+    Error string `json:"error"`
+}
+
+type RevokeAttorneyResponse struct{
+    Error string `json:"error"`
+}
+
+type DelegationChainResponse struct{
+    
+    Result []entity.POA `json:"result"`
+    Error string `json:"error"`
+}
+
+type VerifyAuthorityResponse struct{
+    
+    Result *entity.AuthorityVerdict `json:"result"`
+    Error string `json:"error"`
+}
+
+type GetApprovalsResponse struct{
+    
+    Result []entity.Approval `json:"result"`
+    Error string `json:"error"`
+}
+
+type GetAuditTrailResponse struct{
+    
+    Result []entity.AuditRecord `json:"result"`
+    Error string `json:"error"`
+}
+
+type FindAttorneysResponse struct{
+    
+    Result *entity.POAPage `json:"result"`
+    Error string `json:"error"`
+}
+
 
 type POASearchRequest struct{
     State  *entity.POAState `json:"state"`
     DateFrom  *string `json:"date_from"`
     DateTo  *string `json:"date_to"`
     AuthorityINN  *string `json:"authority_inn"`
+    PrincipalINN  *string `json:"principal_inn"`
+    RepresentativeINN  *string `json:"representative_inn"`
+    ParentID  *string `json:"parent_id"`
+    DateFromRange  *entity.DateRange `json:"date_from_range,omitempty"`
+    DateToRange  *entity.DateRange `json:"date_to_range,omitempty"`
+    ValidAt  *string `json:"valid_at,omitempty"`
+    PageSize  int32 `json:"page_size,omitempty"`
+    Bookmark  string `json:"bookmark,omitempty"`
     
 }
\ No newline at end of file
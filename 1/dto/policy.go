@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"github.com/procsy-tech/attorney/entity"
+)
+
+type GetPolicyRequest struct {
+}
+
+type UpdatePolicyRequest struct {
+	Policy *entity.PolicyTable `json:"policy"`
+}
+
+type GetPolicyResponse struct {
+	Result *entity.PolicyTable `json:"result"`
+	Error  string              `json:"error"`
+}
+
+type UpdatePolicyResponse struct {
+	Error string `json:"error"`
+}
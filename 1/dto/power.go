@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"github.com/procsy-tech/attorney/entity"
+)
+
+type CreatePowerRequest struct {
+	Power *entity.PowerDefinition `json:"power"`
+}
+
+type UpdatePowerRequest struct {
+	Power *entity.PowerDefinition `json:"power"`
+}
+
+type DeletePowerRequest struct {
+	Code string `json:"code"`
+}
+
+type GetPowerRequest struct {
+	Code string `json:"code"`
+}
+
+type ListPowersRequest struct {
+}
+
+type CreatePowerResponse struct {
+	Error string `json:"error"`
+}
+
+type UpdatePowerResponse struct {
+	Error string `json:"error"`
+}
+
+type DeletePowerResponse struct {
+	Error string `json:"error"`
+}
+
+type GetPowerResponse struct {
+	Result *entity.PowerDefinition `json:"result"`
+	Error  string                  `json:"error"`
+}
+
+type ListPowersResponse struct {
+	Result []entity.PowerDefinition `json:"result"`
+	Error  string                   `json:"error"`
+}
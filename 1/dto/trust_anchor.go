@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"github.com/procsy-tech/attorney/entity"
+)
+
+type AddTrustAnchorRequest struct {
+	Anchor *entity.TrustAnchor `json:"anchor"`
+}
+
+type DeleteTrustAnchorRequest struct {
+	Fingerprint string `json:"fingerprint"`
+}
+
+type ListTrustAnchorsRequest struct {
+}
+
+type AddTrustAnchorResponse struct {
+	Error string `json:"error"`
+}
+
+type DeleteTrustAnchorResponse struct {
+	Error string `json:"error"`
+}
+
+type ListTrustAnchorsResponse struct {
+	Result []entity.TrustAnchor `json:"result"`
+	Error  string               `json:"error"`
+}
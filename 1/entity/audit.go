@@ -0,0 +1,18 @@
+package entity
+
+// AuditRecord describes a change of POA made by a transaction.
+type AuditRecord struct {
+	// Transition is the operation which changed POA, e.g. SendAttorney.
+	Transition string   `json:"transition"`
+	From       POAState `json:"from,omitempty"`
+	To         POAState `json:"to"`
+	MSPID      string   `json:"msp_id"`
+	// Subject is the distinguished name of the client certificate subject.
+	Subject string `json:"subject"`
+	// Fingerprint is hex encoded SHA-256 of the client certificate.
+	Fingerprint string `json:"fingerprint"`
+	TxID        string `json:"tx_id"`
+	// Timestamp is the transaction time.
+	Timestamp string `json:"timestamp"`
+	Comment   string `json:"comment,omitempty"`
+}
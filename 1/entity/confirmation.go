@@ -0,0 +1,44 @@
+package entity
+
+// ConfirmationPolicy requires approvals of several organizations before POA is confirmed.
+type ConfirmationPolicy struct {
+	// Required is the number of distinct organizations which must approve POA.
+	Required int `json:"required"`
+	// MSPIDs are the organizations eligible to approve POA.
+	MSPIDs []string `json:"msp_ids"`
+}
+
+// Approval records that the organization approved POA.
+type Approval struct {
+	MSPID string `json:"msp_id"`
+	// ApproverID identifies the client which approved POA on behalf of the organization.
+	ApproverID string `json:"approver_id"`
+	// Timestamp is the approval transaction time in RFC 3339 format.
+	Timestamp string `json:"timestamp"`
+}
+
+// IsEligible reports whether the organization may approve POA under the policy.
+func (p *ConfirmationPolicy) IsEligible(mspID string) bool {
+	for _, eligible := range p.MSPIDs {
+		if eligible == mspID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsApprovedBy reports whether the organization has already approved POA.
+func (e *POA) IsApprovedBy(mspID string) bool {
+	for _, approval := range e.Approvals {
+		if approval.MSPID == mspID {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEnoughApprovals reports whether POA collected approvals required by its confirmation policy.
+// POA without confirmation policy needs no approvals.
+func (e *POA) HasEnoughApprovals() bool {
+	return e.ConfirmationPolicy == nil || len(e.Approvals) >= e.ConfirmationPolicy.Required
+}
@@ -0,0 +1,23 @@
+package entity
+
+// EndorsingMSPIDs returns organizations whose peers must endorse changes of the POA key:
+// the principal's organization and, while POA is not yet confirmed, the representative's one.
+// The representative's organization stops endorsing once POA is confirmed, so it can not block revocation.
+// Returns nil for POA registered before the principal's organization was recorded.
+func (e *POA) EndorsingMSPIDs() []string {
+	if len(e.PrincipalMSPID) == 0 {
+		return nil
+	}
+
+	mspIDs := []string{e.PrincipalMSPID}
+	if len(e.RepresentativeMSPID) != 0 && e.RepresentativeMSPID != e.PrincipalMSPID && e.representativeEndorses() {
+		mspIDs = append(mspIDs, e.RepresentativeMSPID)
+	}
+	return mspIDs
+}
+
+// representativeEndorses reports whether the representative's organization takes part in endorsement,
+// that is POA is neither confirmed nor in a final state.
+func (e *POA) representativeEndorses() bool {
+	return e.State != POAStateConfirmed && !e.IsTerminal()
+}
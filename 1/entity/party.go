@@ -0,0 +1,34 @@
+package entity
+
+// PartyType is a kind of POA party.
+type PartyType string
+
+const (
+	PartyTypeLegalEntity            = "LegalEntity"
+	PartyTypeIndividualEntrepreneur = "IndividualEntrepreneur"
+	PartyTypeIndividual             = "Individual"
+)
+
+// Party is a principal or a representative of POA.
+type Party struct {
+	Type PartyType `json:"type"`
+	INN  string    `json:"inn"`
+	// OGRN holds OGRN for legal entities and OGRNIP for individual entrepreneurs.
+	OGRN  string `json:"ogrn,omitempty"`
+	KPP   string `json:"kpp,omitempty"`
+	SNILS string `json:"snils,omitempty"`
+	// Name is the full name of a legal entity.
+	Name       string `json:"name,omitempty"`
+	LastName   string `json:"last_name,omitempty"`
+	FirstName  string `json:"first_name,omitempty"`
+	MiddleName string `json:"middle_name,omitempty"`
+}
+
+// IsKnownType reports whether party type is one of the supported ones.
+func (p *Party) IsKnownType() bool {
+	switch p.Type {
+	case PartyTypeLegalEntity, PartyTypeIndividualEntrepreneur, PartyTypeIndividual:
+		return true
+	}
+	return false
+}
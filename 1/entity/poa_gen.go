@@ -3,6 +3,8 @@ package entity
 
 import (
 	"fmt"
+
+	"github.com/procsy-tech/attorney/statemachine"
 )
 
 
@@ -12,6 +14,18 @@ type POA struct{
     DateFrom  string `json:"date_from"`
     DateTo  string `json:"date_to"`
     AuthorityINN  string `json:"authority_inn"`
+    Principal  *Party `json:"principal"`
+    Representatives  []Party `json:"representatives"`
+    Revocation  *Revocation `json:"revocation,omitempty"`
+    Powers  []Power `json:"powers"`
+    ParentID  string `json:"parent_id,omitempty"`
+    AllowSubstitution  bool `json:"allow_substitution"`
+    PrincipalMSPID  string `json:"principal_msp_id,omitempty"`
+    RepresentativeMSPID  string `json:"representative_msp_id,omitempty"`
+    Signature  *Signature `json:"signature,omitempty"`
+    ConfirmationPolicy  *ConfirmationPolicy `json:"confirmation_policy,omitempty"`
+    Approvals  []Approval `json:"approvals,omitempty"`
+    Audit  []AuditRecord `json:"audit,omitempty"`
     
 }
 
@@ -21,6 +35,14 @@ type POASearchRequest struct{
     DateFrom  *string `json:"date_from"`
     DateTo  *string `json:"date_to"`
     AuthorityINN  *string `json:"authority_inn"`
+    PrincipalINN  *string `json:"principal_inn"`
+    RepresentativeINN  *string `json:"representative_inn"`
+    ParentID  *string `json:"parent_id"`
+    DateFromRange  *DateRange `json:"date_from_range,omitempty"`
+    DateToRange  *DateRange `json:"date_to_range,omitempty"`
+    ValidAt  *string `json:"valid_at,omitempty"`
+    PageSize  int32 `json:"page_size,omitempty"`
+    Bookmark  string `json:"bookmark,omitempty"`
     
 }
 
@@ -33,50 +55,78 @@ const(
     POAStateReturned = "Returned"
     POAStateConfirmed = "Confirmed"
     POAStateRejected = "Rejected"
+    POAStateRevoked = "Revoked"
     
 )
 
 
+// POAStateModel is the ПростоеСогласование1 state model from chaincode.yaml.
+const POAStateModel = `@startuml
+  [*] --> Created
+  Created --> Sent
+  Sent --> Returned
+  Sent --> Confirmed
+  Sent --> Rejected
+  Confirmed --> Revoked
+  Returned --> Sent
+  Rejected --> [*]
+  Revoked --> [*]
+@enduml`
+
+// POAStateMachine checks POA state transitions.
+var POAStateMachine = statemachine.MustParse(POAStateModel)
+
+// setState moves POA into the state if the state model allows it.
+func (e *POA) setState(state POAState) error {
+    if !POAStateMachine.CanTransit(string(e.State), string(state)) {
+        return fmt.Errorf("POA in state %s can not be set into '%s' (correct states: %v)",
+            e.State, state, POAStateMachine.Sources(string(state)))
+    }
+
+    e.State = state
+    return nil
+}
+
+// NextStates returns states POA can be moved into from the current state.
+func (e *POA) NextStates() []POAState {
+    var states []POAState
+    for _, s := range POAStateMachine.Next(string(e.State)) {
+        states = append(states, POAState(s))
+    }
+    return states
+}
+
+// IsTerminal reports whether POA reached a final state.
+func (e *POA) IsTerminal() bool {
+    return e.State != "" && POAStateMachine.IsTerminal(string(e.State))
+}
+
 // SetStateCreated .
 func (e *POA) SetStateCreated() error {
-    e.State = POAStateCreated
-    return nil
+    return e.setState(POAStateCreated)
 }
 
 // SetStateSent .
-func (e *POA) SetStateSent() error {if(e.State !=  POAStateCreated)||(e.State !=  POAStateReturned){
-            return fmt.Errorf(" Order in state %s can not be set into 'Sent' (correct states: [Created Returned] )", e.State)
-    }
-    
-    e.State = POAStateSent
-    return nil
+func (e *POA) SetStateSent() error {
+    return e.setState(POAStateSent)
 }
 
 // SetStateReturned .
-func (e *POA) SetStateReturned() error {if(e.State !=  POAStateSent){
-            return fmt.Errorf(" Order in state %s can not be set into 'Returned' (correct states: [Sent] )", e.State)
-    }
-    
-    e.State = POAStateReturned
-    return nil
+func (e *POA) SetStateReturned() error {
+    return e.setState(POAStateReturned)
 }
 
 // SetStateConfirmed .
-func (e *POA) SetStateConfirmed() error {if(e.State !=  POAStateSent){
-            return fmt.Errorf(" Order in state %s can not be set into 'Confirmed' (correct states: [Sent] )", e.State)
-    }
-    
-    e.State = POAStateConfirmed
-    return nil
+func (e *POA) SetStateConfirmed() error {
+    return e.setState(POAStateConfirmed)
 }
 
 // SetStateRejected .
-func (e *POA) SetStateRejected() error {if(e.State !=  POAStateSent){
-            return fmt.Errorf(" Order in state %s can not be set into 'Rejected' (correct states: [Sent] )", e.State)
-    }
-    
-    e.State = POAStateRejected
-    return nil
+func (e *POA) SetStateRejected() error {
+    return e.setState(POAStateRejected)
 }
 
-
+// SetStateRevoked .
+func (e *POA) SetStateRevoked() error {
+    return e.setState(POAStateRevoked)
+}
@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// POADateLayout is ISO-8601 calendar date layout of DateFrom and DateTo.
+const POADateLayout = "2006-01-02"
+
+// POAEffectiveStatus is POA validity at some moment derived from its period.
+type POAEffectiveStatus string
+
+const (
+	POAEffectiveStatusNotYetValid = "NotYetValid"
+	POAEffectiveStatusActive      = "Active"
+	POAEffectiveStatusExpired     = "Expired"
+)
+
+// ParsePOADate parses ISO-8601 date or date-time (RFC 3339). Dates are treated as UTC.
+func ParsePOADate(value string) (time.Time, error) {
+	t, err := time.Parse(POADateLayout, value)
+	if err == nil {
+		return t, nil
+	}
+	t, err = time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not an ISO-8601 date", value)
+	}
+	return t.UTC(), nil
+}
+
+// ValidityPeriod returns POA validity bounds, the end bound is exclusive.
+// DateTo given as a calendar date covers the whole day.
+func (e *POA) ValidityPeriod() (from time.Time, to time.Time, err error) {
+	from, err = ParsePOADate(e.DateFrom)
+	if err != nil {
+		return from, to, fmt.Errorf("date_from: %s", err)
+	}
+	to, err = ParsePOADate(e.DateTo)
+	if err != nil {
+		return from, to, fmt.Errorf("date_to: %s", err)
+	}
+	if len(e.DateTo) == len(POADateLayout) {
+		to = to.AddDate(0, 0, 1)
+	} else {
+		to = to.Add(time.Nanosecond)
+	}
+	if !from.Before(to) {
+		return from, to, fmt.Errorf("date_to: %s is before date_from %s", e.DateTo, e.DateFrom)
+	}
+	return from, to, nil
+}
+
+// EffectiveStatus returns POA validity at the moment, use transaction time as the moment.
+func (e *POA) EffectiveStatus(at time.Time) (POAEffectiveStatus, error) {
+	from, to, err := e.ValidityPeriod()
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case at.Before(from):
+		return POAEffectiveStatusNotYetValid, nil
+	case at.Before(to):
+		return POAEffectiveStatusActive, nil
+	}
+	return POAEffectiveStatusExpired, nil
+}
+
+// InForce reports whether POA grants authority at the moment:
+// it is confirmed, not revoked and the moment is inside its validity period.
+func (e *POA) InForce(at time.Time) (bool, error) {
+	if e.State != POAStateConfirmed || e.IsRevoked() {
+		return false, nil
+	}
+	status, err := e.EffectiveStatus(at)
+	if err != nil {
+		return false, err
+	}
+	return status == POAEffectiveStatusActive, nil
+}
+
+// WasInForce reports whether POA granted authority at the moment according to its current version:
+// it is confirmed or was revoked after the moment, and the moment is inside its validity period.
+func (e *POA) WasInForce(at time.Time) (bool, error) {
+	switch {
+	case e.State == POAStateConfirmed && e.Revocation == nil:
+	case e.State == POAStateRevoked && e.Revocation != nil:
+		revokedAt, err := time.Parse(time.RFC3339, e.Revocation.Timestamp)
+		if err != nil {
+			return false, fmt.Errorf("revocation timestamp: %s", err)
+		}
+		if !at.Before(revokedAt) {
+			return false, nil
+		}
+	default:
+		return false, nil
+	}
+	status, err := e.EffectiveStatus(at)
+	if err != nil {
+		return false, err
+	}
+	return status == POAEffectiveStatusActive, nil
+}
@@ -0,0 +1,35 @@
+package entity
+
+// PolicyRule lists requirements to the client identity calling a route.
+// The client must satisfy every non-empty requirement.
+type PolicyRule struct {
+	// MSPIDs the client must belong to one of.
+	MSPIDs []string `json:"msp_ids,omitempty"`
+	// Attributes the client certificate must carry with exactly these values.
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// Roles the client must have one of, in the role attribute or in the certificate OU.
+	Roles []string `json:"roles,omitempty"`
+}
+
+// PolicyTable maps api routes to rules. Routes which are not listed are open to any channel member
+// unless Default rule is set. Governance routes are closed unless listed.
+type PolicyTable struct {
+	Routes  map[string]PolicyRule `json:"routes"`
+	Default *PolicyRule           `json:"default,omitempty"`
+}
+
+// Rule returns the rule which applies to the route.
+func (t *PolicyTable) Rule(route string) (*PolicyRule, bool) {
+	if rule, ok := t.Routes[route]; ok {
+		return &rule, true
+	}
+	if t.Default != nil {
+		return t.Default, true
+	}
+	return nil, false
+}
+
+// IsOpen reports whether the rule lets any channel member in.
+func (r *PolicyRule) IsOpen() bool {
+	return len(r.MSPIDs) == 0 && len(r.Attributes) == 0 && len(r.Roles) == 0
+}
@@ -0,0 +1,33 @@
+package entity
+
+// Power is an action the representative may perform on behalf of the principal.
+type Power struct {
+	Code        string `json:"code"`
+	Description string `json:"description,omitempty"`
+}
+
+// HasPower reports whether POA grants the power.
+func (e *POA) HasPower(code string) bool {
+	for _, p := range e.Powers {
+		if p.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRepresentative reports whether party with the INN is a representative of POA.
+func (e *POA) HasRepresentative(INN string) bool {
+	for _, r := range e.Representatives {
+		if r.INN == INN {
+			return true
+		}
+	}
+	return false
+}
+
+// PowerDefinition is an entry of the powers catalog. POA may grant only cataloged powers.
+type PowerDefinition struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
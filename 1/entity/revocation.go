@@ -0,0 +1,16 @@
+package entity
+
+// Revocation records who revoked POA, when and why.
+type Revocation struct {
+	Reason string `json:"reason"`
+	// RevokerMSPID and RevokerID identify the client which revoked POA.
+	RevokerMSPID string `json:"revoker_msp_id"`
+	RevokerID    string `json:"revoker_id"`
+	// Timestamp is the revocation transaction time in RFC 3339 format.
+	Timestamp string `json:"timestamp"`
+}
+
+// IsRevoked reports whether POA was revoked.
+func (e *POA) IsRevoked() bool {
+	return e.State == POAStateRevoked || e.Revocation != nil
+}
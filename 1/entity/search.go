@@ -0,0 +1,65 @@
+package entity
+
+// POAPage is a page of POA search results.
+type POAPage struct {
+	POAs []POA `json:"poas"`
+	// Bookmark is passed with the next page request to continue the search.
+	Bookmark string `json:"bookmark,omitempty"`
+}
+
+// DateRange bounds calendar dates, both bounds are optional and inclusive.
+type DateRange struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+// Contains reports whether the calendar date of ISO-8601 date or date-time value is within the range.
+func (r *DateRange) Contains(value string) bool {
+	if len(value) < len(POADateLayout) {
+		return false
+	}
+	date := value[:len(POADateLayout)]
+	return (len(r.From) == 0 || date >= r.From) && (len(r.To) == 0 || date <= r.To)
+}
+
+// Matches reports whether POA satisfies every criterion set in the search request.
+func (r *POASearchRequest) Matches(e *POA) bool {
+	if r.State != nil && e.State != *r.State {
+		return false
+	}
+	if r.DateFrom != nil && e.DateFrom != *r.DateFrom {
+		return false
+	}
+	if r.DateTo != nil && e.DateTo != *r.DateTo {
+		return false
+	}
+	if r.AuthorityINN != nil && e.AuthorityINN != *r.AuthorityINN {
+		return false
+	}
+	if r.PrincipalINN != nil && (e.Principal == nil || e.Principal.INN != *r.PrincipalINN) {
+		return false
+	}
+	if r.RepresentativeINN != nil && !e.HasRepresentative(*r.RepresentativeINN) {
+		return false
+	}
+	if r.ParentID != nil && e.ParentID != *r.ParentID {
+		return false
+	}
+	if r.DateFromRange != nil && !r.DateFromRange.Contains(e.DateFrom) {
+		return false
+	}
+	if r.DateToRange != nil && !r.DateToRange.Contains(e.DateTo) {
+		return false
+	}
+	if r.ValidAt != nil {
+		at, err := ParsePOADate(*r.ValidAt)
+		if err != nil {
+			return false
+		}
+		inForce, err := e.WasInForce(at)
+		if err != nil || !inForce {
+			return false
+		}
+	}
+	return true
+}
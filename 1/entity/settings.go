@@ -0,0 +1,7 @@
+package entity
+
+// Settings are chaincode settings loaded from Init config.
+type Settings struct {
+	// Debug enables the _debug route for admin clients.
+	Debug bool `json:"debug"`
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"encoding/json"
+)
+
+// Signature is a detached signature of the POA made by the principal, either a raw signature value
+// with the certificate chain or a CMS container.
+type Signature struct {
+	// Value is base64 encoded signature of POA.SigningPayload.
+	Value string `json:"value,omitempty"`
+	// Certificate is PEM encoded signer certificate followed by intermediate CA certificates.
+	// Optional with Container, which usually bundles the certificates itself.
+	Certificate string `json:"certificate,omitempty"`
+	// Container is base64 encoded detached CMS SignedData (.sig file) of POA.SigningPayload.
+	Container string `json:"container,omitempty"`
+	// Fingerprint is hex encoded SHA-256 of the signer certificate, set on registration.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// VerifiedAt is the transaction time the signature was verified at on registration.
+	VerifiedAt string `json:"verified_at,omitempty"`
+}
+
+// SigningPayload returns canonical serialization of the POA which the principal signs:
+// JSON of the POA without the fields set by the chaincode and without the signature.
+func (e *POA) SigningPayload() ([]byte, error) {
+	payload := *e
+	payload.BlockchainID = ""
+	payload.State = ""
+	payload.Revocation = nil
+	payload.PrincipalMSPID = ""
+	payload.Signature = nil
+	payload.Approvals = nil
+	payload.Audit = nil
+	return json.Marshal(payload)
+}
@@ -0,0 +1,10 @@
+package entity
+
+// TrustAnchor is a root CA certificate which signer certificates must chain to.
+type TrustAnchor struct {
+	// Fingerprint is hex encoded SHA-256 of the certificate, set on registration.
+	Fingerprint string `json:"fingerprint"`
+	Name        string `json:"name"`
+	// Certificate is PEM encoded CA certificate.
+	Certificate string `json:"certificate"`
+}
@@ -0,0 +1,13 @@
+package entity
+
+// AuthorityVerdict answers whether representative may perform action for principal at the moment.
+type AuthorityVerdict struct {
+	Authorized bool `json:"authorized"`
+	// At is the moment of verification in RFC 3339 format.
+	At string `json:"at"`
+	// POAs justifies positive verdict: POA granted to the representative
+	// followed by its parent POAs up to the one granted by the principal.
+	POAs []POA `json:"poas"`
+	// Reason explains negative verdict.
+	Reason string `json:"reason,omitempty"`
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/kbkontrakt/hlfabric-ccdevkit/debug"
+	"github.com/procsy-tech/attorney/registry"
+)
+
+// debugEventName is the name of the chaincode event emitted on every allowed _debug call.
+const debugEventName = "attorney.debug"
+
+// DebugEvent is the payload of the debug chaincode event.
+type DebugEvent struct {
+	ClientID string `json:"client_id"`
+	MSPID    string `json:"msp_id"`
+	TxID     string `json:"tx_id"`
+	Command  string `json:"command,omitempty"`
+}
+
+// Debug serves the _debug route. It is allowed to admins only when enabled by Init config,
+// every call is audit-logged and allowed calls emit the debug chaincode event.
+// Denied calls are recorded in the peer log only: events of failed proposals are never committed.
+func (chaincode *attorneyChaincode) Debug(svcFactory registry.ServiceLocator, stub shim.ChaincodeStubInterface, args []string) ([]byte, error) {
+	identity := svcFactory.Identity()
+	event := DebugEvent{TxID: stub.GetTxID()}
+	event.ClientID, _ = identity.ID()
+	event.MSPID, _ = identity.MSPID()
+	if len(args) != 0 {
+		event.Command = args[0]
+	}
+
+	err := svcFactory.PolicyService().AuthorizeDebug()
+	if err != nil {
+		chaincode.logger.Warningf("AUDIT debug call denied client=[%s] msp=[%s] tx=[%s] command=[%s]: %s",
+			event.ClientID, event.MSPID, event.TxID, event.Command, err)
+		return nil, err
+	}
+	chaincode.logger.Warningf("AUDIT debug call client=[%s] msp=[%s] tx=[%s] command=[%s]",
+		event.ClientID, event.MSPID, event.TxID, event.Command)
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	err = stub.SetEvent(debugEventName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return debug.Invoke(stub, args)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/procsy-tech/attorney/dto"
 	"github.com/procsy-tech/attorney/registry"
+	"github.com/procsy-tech/attorney/service"
 )
 // Create .
 func (chaincode *attorneyChaincode) Create(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
@@ -27,6 +28,9 @@ func (chaincode *attorneyChaincode) Create(svcFactory registry.ServiceLocator, a
 	if err != nil{
 		chaincode.logger.Infof("error invoking method Create: %s", err)
 	}
+	if service.IsAccessDenied(err) {
+		return nil, err
+	}
 	response := dto.CreateResponse{
 	
     	Result: result,
@@ -56,10 +60,13 @@ func (chaincode *attorneyChaincode) ConfirmAttorney(svcFactory registry.ServiceL
 		return nil, fmt.Errorf("failed to parse request payload: %s", err)
 	}
 	
-	err = svcFactory.POAService().ConfirmAttorney(request.ID)
+	err = svcFactory.POAService().ConfirmAttorney(request.ID, request.Comment)
 	if err != nil{
 		chaincode.logger.Infof("error invoking method ConfirmAttorney: %s", err)
 	}
+	if service.IsAccessDenied(err) {
+		return nil, err
+	}
 	response := dto.ConfirmAttorneyResponse{
 	}
 	if err != nil{
@@ -72,6 +79,304 @@ func (chaincode *attorneyChaincode) ConfirmAttorney(svcFactory registry.ServiceL
 
 	return resultData, nil
 }
+// SendAttorney .
+func (chaincode *attorneyChaincode) SendAttorney(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.SendAttorneyRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	err = svcFactory.POAService().SendAttorney(request.ID, request.Comment)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method SendAttorney: %s", err)
+	}
+	if service.IsAccessDenied(err) {
+		return nil, err
+	}
+	response := dto.SendAttorneyResponse{
+	}
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// ReturnAttorney .
+func (chaincode *attorneyChaincode) ReturnAttorney(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.ReturnAttorneyRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	err = svcFactory.POAService().ReturnAttorney(request.ID, request.Comment)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method ReturnAttorney: %s", err)
+	}
+	if service.IsAccessDenied(err) {
+		return nil, err
+	}
+	response := dto.ReturnAttorneyResponse{
+	}
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// RejectAttorney .
+func (chaincode *attorneyChaincode) RejectAttorney(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.RejectAttorneyRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	err = svcFactory.POAService().RejectAttorney(request.ID, request.Comment)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method RejectAttorney: %s", err)
+	}
+	if service.IsAccessDenied(err) {
+		return nil, err
+	}
+	response := dto.RejectAttorneyResponse{
+	}
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// RevokeAttorney .
+func (chaincode *attorneyChaincode) RevokeAttorney(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.RevokeAttorneyRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	err = svcFactory.POAService().RevokeAttorney(request.ID, request.Reason)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method RevokeAttorney: %s", err)
+	}
+	if service.IsAccessDenied(err) {
+		return nil, err
+	}
+	response := dto.RevokeAttorneyResponse{
+	}
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// DelegationChain .
+func (chaincode *attorneyChaincode) DelegationChain(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.DelegationChainRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	result, err := svcFactory.POAService().DelegationChain(request.ID)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method DelegationChain: %s", err)
+	}
+	response := dto.DelegationChainResponse{
+	
+    	Result: result,
+    }
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// VerifyAuthority .
+func (chaincode *attorneyChaincode) VerifyAuthority(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.VerifyAuthorityRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	result, err := svcFactory.POAService().VerifyAuthority(request.RepresentativeINN, request.PrincipalINN, request.PowerCode, request.Timestamp)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method VerifyAuthority: %s", err)
+	}
+	response := dto.VerifyAuthorityResponse{
+	
+    	Result: result,
+    }
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// GetApprovals .
+func (chaincode *attorneyChaincode) GetApprovals(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.GetApprovalsRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	result, err := svcFactory.POAService().GetApprovals(request.ID)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method GetApprovals: %s", err)
+	}
+	response := dto.GetApprovalsResponse{
+	
+    	Result: result,
+    }
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// GetAuditTrail .
+func (chaincode *attorneyChaincode) GetAuditTrail(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
 
+	data := []byte(payload)
+	var request dto.GetAuditTrailRequest
 
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	result, err := svcFactory.POAService().GetAuditTrail(request.ID)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method GetAuditTrail: %s", err)
+	}
+	response := dto.GetAuditTrailResponse{
+	
+    	Result: result,
+    }
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// FindAttorneys .
+func (chaincode *attorneyChaincode) FindAttorneys(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.FindAttorneysRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	result, err := svcFactory.POAService().FindAttorneys(request.Request)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method FindAttorneys: %s", err)
+	}
+	response := dto.FindAttorneysResponse{
+	
+    	Result: result,
+    }
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
 
+	return resultData, nil
+}
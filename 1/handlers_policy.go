@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/procsy-tech/attorney/dto"
+	"github.com/procsy-tech/attorney/registry"
+)
+// GetPolicy .
+func (chaincode *attorneyChaincode) GetPolicy(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.GetPolicyRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	result, err := svcFactory.PolicyService().GetPolicy()
+	if err != nil{
+		chaincode.logger.Infof("error invoking method GetPolicy: %s", err)
+	}
+	response := dto.GetPolicyResponse{
+	
+    	Result: result,
+    }
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// UpdatePolicy .
+func (chaincode *attorneyChaincode) UpdatePolicy(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.UpdatePolicyRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	err = svcFactory.PolicyService().UpdatePolicy(request.Policy)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method UpdatePolicy: %s", err)
+	}
+	response := dto.UpdatePolicyResponse{
+	}
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/procsy-tech/attorney/dto"
+	"github.com/procsy-tech/attorney/registry"
+)
+// CreatePower .
+func (chaincode *attorneyChaincode) CreatePower(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.CreatePowerRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	err = svcFactory.PowerService().CreatePower(request.Power)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method CreatePower: %s", err)
+	}
+	response := dto.CreatePowerResponse{
+	}
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// UpdatePower .
+func (chaincode *attorneyChaincode) UpdatePower(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.UpdatePowerRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	err = svcFactory.PowerService().UpdatePower(request.Power)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method UpdatePower: %s", err)
+	}
+	response := dto.UpdatePowerResponse{
+	}
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// DeletePower .
+func (chaincode *attorneyChaincode) DeletePower(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.DeletePowerRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	err = svcFactory.PowerService().DeletePower(request.Code)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method DeletePower: %s", err)
+	}
+	response := dto.DeletePowerResponse{
+	}
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// GetPower .
+func (chaincode *attorneyChaincode) GetPower(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.GetPowerRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	result, err := svcFactory.PowerService().GetPower(request.Code)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method GetPower: %s", err)
+	}
+	response := dto.GetPowerResponse{
+	
+    	Result: result,
+    }
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// ListPowers .
+func (chaincode *attorneyChaincode) ListPowers(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.ListPowersRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	result, err := svcFactory.PowerService().ListPowers()
+	if err != nil{
+		chaincode.logger.Infof("error invoking method ListPowers: %s", err)
+	}
+	response := dto.ListPowersResponse{
+	
+    	Result: result,
+    }
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/procsy-tech/attorney/api"
+	"github.com/procsy-tech/attorney/registry"
+	"github.com/procsy-tech/attorney/service"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// testServiceLocator serves the services of the test, other services are not available.
+type testServiceLocator struct {
+	registry.ServiceLocator
+	poa service.POAService
+}
+
+func (sl *testServiceLocator) POAService() service.POAService       { return sl.poa }
+func (sl *testServiceLocator) PolicyService() service.PolicyService { return openPolicyService{} }
+
+// openPolicyService allows every route.
+type openPolicyService struct {
+	service.PolicyService
+}
+
+func (openPolicyService) Authorize(Route string) error { return nil }
+
+// transitionPOAService denies transitions of POA1 and fails transitions of other POAs.
+type transitionPOAService struct {
+	service.POAService
+}
+
+func (transitionPOAService) transit(operation string, ID string) error {
+	if ID == "POA1" {
+		return &service.AccessDeniedError{Operation: operation, Reason: "client does not act for the principal"}
+	}
+	return errors.New("POA not found")
+}
+
+func (svc transitionPOAService) SendAttorney(ID string, Comment string) error {
+	return svc.transit("SendAttorney", ID)
+}
+
+func (svc transitionPOAService) ReturnAttorney(ID string, Comment string) error {
+	return svc.transit("ReturnAttorney", ID)
+}
+
+func TestTransitionHandlers(t *testing.T) {
+	Convey("Given chaincode", t, func(c C) {
+		chaincode := NewattorneyChaincode()
+		svcFactory := &testServiceLocator{poa: transitionPOAService{}}
+		stub := shim.NewMockStub("attorney", nil)
+
+		for _, route := range []string{api.SendAttorney, api.ReturnAttorney} {
+			route := route
+			c.Convey("When "+route+" is denied", func(c C) {
+				response := chaincode.route(svcFactory, stub, route, []string{`{"id":"POA1"}`})
+				c.Convey("It should respond forbidden", func(c C) {
+					So(response.Status, ShouldEqual, statusForbidden)
+					So(response.Message, ShouldContainSubstring, "access denied")
+				})
+			})
+			c.Convey("When "+route+" fails", func(c C) {
+				response := chaincode.route(svcFactory, stub, route, []string{`{"id":"POA2"}`})
+				c.Convey("It should respond with the error in the payload", func(c C) {
+					So(response.Status, ShouldEqual, shim.OK)
+					So(string(response.Payload), ShouldContainSubstring, "POA not found")
+				})
+			})
+		}
+	})
+}
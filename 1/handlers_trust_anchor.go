@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/procsy-tech/attorney/dto"
+	"github.com/procsy-tech/attorney/registry"
+)
+// AddTrustAnchor .
+func (chaincode *attorneyChaincode) AddTrustAnchor(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.AddTrustAnchorRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	err = svcFactory.TrustAnchorService().AddTrustAnchor(request.Anchor)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method AddTrustAnchor: %s", err)
+	}
+	response := dto.AddTrustAnchorResponse{
+	}
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// DeleteTrustAnchor .
+func (chaincode *attorneyChaincode) DeleteTrustAnchor(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.DeleteTrustAnchorRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	err = svcFactory.TrustAnchorService().DeleteTrustAnchor(request.Fingerprint)
+	if err != nil{
+		chaincode.logger.Infof("error invoking method DeleteTrustAnchor: %s", err)
+	}
+	response := dto.DeleteTrustAnchorResponse{
+	}
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
+// ListTrustAnchors .
+func (chaincode *attorneyChaincode) ListTrustAnchors(svcFactory registry.ServiceLocator, args []string) ([]byte, error) {
+	payload := args[0]
+	if len(payload) == 0 {
+		return nil, errors.New("empty request payload")
+	}
+
+	data := []byte(payload)
+	var request dto.ListTrustAnchorsRequest
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request payload: %s", err)
+	}
+	
+	result, err := svcFactory.TrustAnchorService().ListTrustAnchors()
+	if err != nil{
+		chaincode.logger.Infof("error invoking method ListTrustAnchors: %s", err)
+	}
+	response := dto.ListTrustAnchorsResponse{
+	
+    	Result: result,
+    }
+	if err != nil{
+		response.Error = err.Error()
+	}
+	resultData, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultData, nil
+}
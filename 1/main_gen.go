@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/hyperledger/fabric/core/chaincode/shim"
@@ -9,18 +10,27 @@ import (
 	"github.com/kbkontrakt/hlfabric-ccdevkit/logs"
 	"github.com/kbkontrakt/hlfabric-ccdevkit/utils"
 	"github.com/procsy-tech/attorney/api"
+	"github.com/procsy-tech/attorney/entity"
 	"github.com/procsy-tech/attorney/registry"
+	"github.com/procsy-tech/attorney/service"
 )
 
 const (
 	chaincodeVersion      = "0.1.0"
 	attorneyCollectionName = "attorneys"
+
+	// statusForbidden is the response status of calls denied by the client identity checks.
+	statusForbidden = 403
 )
 
-// Config .
+// Config is passed as the first Init argument, all fields are optional.
 type Config struct {
 	Version     string `json:"version"`
 	ChaincodeID string `json:"chaincode_id"`
+	// Policy replaces route authorization policy table stored in the ledger.
+	Policy *entity.PolicyTable `json:"policy,omitempty"`
+	// Debug enables the _debug route for admin clients, it is disabled unless set on every Init.
+	Debug bool `json:"debug,omitempty"`
 }
 
 type attorneyChaincode struct {
@@ -47,6 +57,32 @@ func (chaincode *attorneyChaincode) Init(stub shim.ChaincodeStubInterface) peer.
 		logger.Info("Call")
 	}
 
+	var config Config
+	if len(args) != 0 && len(args[0]) != 0 {
+		err := json.Unmarshal([]byte(args[0]), &config)
+		if err != nil {
+			return shim.Error(fmt.Sprintf("failed to parse config: %s", err))
+		}
+	}
+
+	policySvc := registry.NewServiceLocatorImpl(stub).PolicyService()
+
+	if config.Policy != nil {
+		err := policySvc.UpdatePolicy(config.Policy)
+		if err != nil {
+			return shim.Error(fmt.Sprintf("failed to load policy table: %s", err))
+		}
+		logger.Infof("Policy table of %d routes is loaded", len(config.Policy.Routes))
+	}
+
+	err := policySvc.EnableDebug(config.Debug)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("failed to save settings: %s", err))
+	}
+	if config.Debug {
+		logger.Warning("Debug route is enabled")
+	}
+
 	return shim.Success(nil)
 }
 
@@ -80,24 +116,76 @@ func (chaincode *attorneyChaincode) Invoke(stub shim.ChaincodeStubInterface) (re
 }
 
 func (chaincode *attorneyChaincode) handleByRoute(stub shim.ChaincodeStubInterface, fn string, args []string) peer.Response {
-	svcFactory := registry.NewServiceLocatorImpl(stub)
+	return chaincode.route(registry.NewServiceLocatorImpl(stub), stub, fn, args)
+}
 
+// route authorizes the call and passes it to the handler of the route.
+func (chaincode *attorneyChaincode) route(svcFactory registry.ServiceLocator, stub shim.ChaincodeStubInterface, fn string, args []string) peer.Response {
 	var err error
 	var payload []byte
 
+	err = svcFactory.PolicyService().Authorize(fn)
+	if service.IsAccessDenied(err) {
+		return peer.Response{Status: statusForbidden, Message: err.Error()}
+	}
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
 	switch fn {
 		case api.Create:
         payload, err = chaincode.Create(svcFactory, args)
     case api.ConfirmAttorney:
         payload, err = chaincode.ConfirmAttorney(svcFactory, args)
+    case api.SendAttorney:
+        payload, err = chaincode.SendAttorney(svcFactory, args)
+    case api.ReturnAttorney:
+        payload, err = chaincode.ReturnAttorney(svcFactory, args)
+    case api.RejectAttorney:
+        payload, err = chaincode.RejectAttorney(svcFactory, args)
+    case api.RevokeAttorney:
+        payload, err = chaincode.RevokeAttorney(svcFactory, args)
+    case api.DelegationChain:
+        payload, err = chaincode.DelegationChain(svcFactory, args)
+    case api.VerifyAuthority:
+        payload, err = chaincode.VerifyAuthority(svcFactory, args)
+    case api.GetApprovals:
+        payload, err = chaincode.GetApprovals(svcFactory, args)
+    case api.GetAuditTrail:
+        payload, err = chaincode.GetAuditTrail(svcFactory, args)
+    case api.FindAttorneys:
+        payload, err = chaincode.FindAttorneys(svcFactory, args)
+    case api.CreatePower:
+        payload, err = chaincode.CreatePower(svcFactory, args)
+    case api.UpdatePower:
+        payload, err = chaincode.UpdatePower(svcFactory, args)
+    case api.DeletePower:
+        payload, err = chaincode.DeletePower(svcFactory, args)
+    case api.GetPower:
+        payload, err = chaincode.GetPower(svcFactory, args)
+    case api.ListPowers:
+        payload, err = chaincode.ListPowers(svcFactory, args)
+    case api.GetPolicy:
+        payload, err = chaincode.GetPolicy(svcFactory, args)
+    case api.UpdatePolicy:
+        payload, err = chaincode.UpdatePolicy(svcFactory, args)
+    case api.AddTrustAnchor:
+        payload, err = chaincode.AddTrustAnchor(svcFactory, args)
+    case api.DeleteTrustAnchor:
+        payload, err = chaincode.DeleteTrustAnchor(svcFactory, args)
+    case api.ListTrustAnchors:
+        payload, err = chaincode.ListTrustAnchors(svcFactory, args)
     
 
 	case "_debug":
-		payload, err = debug.Invoke(stub, args)
+		payload, err = chaincode.Debug(svcFactory, stub, args)
 	default:
 		return shim.Error("unsupported function")
 	}
 
+	if service.IsAccessDenied(err) {
+		return peer.Response{Status: statusForbidden, Message: err.Error()}
+	}
 	if err != nil {
 		return shim.Error(err.Error())
 	}
@@ -18,43 +18,43 @@ type POAService struct {
 }
 
 
-func (svc *POAService) Create(POA *entity.POA) (int64, error){
+func (svc *POAService) Create(POA *entity.POA) (string, error){
 	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
 			{ID: "attorney"},
-		}, api.Create, POA)
+		}, api.Create, dto.CreateRequest{POA: POA})
 	if err != nil{
-		return 0,  fmt.Errorf("error creating ccRequest: %s", err)
+		return "",  fmt.Errorf("error creating ccRequest: %s", err)
 	}
 	var ccResponse channel.Response
 	
 		ccResponse, err = svc.channelClient.Execute(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
 		if err != nil {
-			return 0,  fmt.Errorf("failed to execute: %s", err)
+			return "",  fmt.Errorf("failed to execute: %s", err)
 		}
 	
 
 	if ccResponse.ChaincodeStatus != 200 {
-		return 0,  errors.New(string(ccResponse.Payload))
+		return "",  errors.New(string(ccResponse.Payload))
 	}
 
 	var response dto.CreateResponse
 	err = json.Unmarshal(ccResponse.Payload, &response)
 	if err != nil {
-		return 0,  fmt.Errorf("failed to parse response payload: %s", err)
+		return "",  fmt.Errorf("failed to parse response payload: %s", err)
 	}
 
 	if len(response.Error) != 0{
-		return 0,  errors.New(response.Error)
+		return "",  errors.New(response.Error)
 	}
 
 	
     	return response.Result, nil
 	}
 
-func (svc *POAService) ConfirmAttorney(ID string) error{
+func (svc *POAService) ConfirmAttorney(ID string, Comment string) error{
 	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
 			{ID: "attorney"},
-		}, api.ConfirmAttorney, ID)
+		}, api.ConfirmAttorney, dto.ConfirmAttorneyRequest{ID: ID, Comment: Comment})
 	if err != nil{
 		return  fmt.Errorf("error creating ccRequest: %s", err)
 	}
@@ -84,6 +84,308 @@ func (svc *POAService) ConfirmAttorney(ID string) error{
 	return nil
     }
 
+func (svc *POAService) SendAttorney(ID string, Comment string) error{
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.SendAttorney, dto.SendAttorneyRequest{ID: ID, Comment: Comment})
+	if err != nil{
+		return  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Execute(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.SendAttorneyResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return  errors.New(response.Error)
+	}
+
+	
+	return nil
+    }
+
+func (svc *POAService) ReturnAttorney(ID string, Comment string) error{
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.ReturnAttorney, dto.ReturnAttorneyRequest{ID: ID, Comment: Comment})
+	if err != nil{
+		return  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Execute(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.ReturnAttorneyResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return  errors.New(response.Error)
+	}
+
+	
+	return nil
+    }
+
+func (svc *POAService) RejectAttorney(ID string, Comment string) error{
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.RejectAttorney, dto.RejectAttorneyRequest{ID: ID, Comment: Comment})
+	if err != nil{
+		return  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Execute(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.RejectAttorneyResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return  errors.New(response.Error)
+	}
+
+	
+	return nil
+    }
+
+func (svc *POAService) RevokeAttorney(ID string, Reason string) error{
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.RevokeAttorney, dto.RevokeAttorneyRequest{ID: ID, Reason: Reason})
+	if err != nil{
+		return  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Execute(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.RevokeAttorneyResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return  errors.New(response.Error)
+	}
+
+	
+	return nil
+    }
+
+
+func (svc *POAService) DelegationChain(ID string) ([]entity.POA, error){
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.DelegationChain, dto.DelegationChainRequest{ID: ID})
+	if err != nil{
+		return nil,  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Query(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return nil,  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return nil,  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.DelegationChainResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return nil,  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return nil,  errors.New(response.Error)
+	}
+
+	
+    	return response.Result, nil
+	}
+
+func (svc *POAService) VerifyAuthority(RepresentativeINN string, PrincipalINN string, PowerCode string, Timestamp string) (*entity.AuthorityVerdict, error){
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.VerifyAuthority, dto.VerifyAuthorityRequest{
+			RepresentativeINN: RepresentativeINN,
+			PrincipalINN: PrincipalINN,
+			PowerCode: PowerCode,
+			Timestamp: Timestamp,
+		})
+	if err != nil{
+		return nil,  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Query(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return nil,  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return nil,  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.VerifyAuthorityResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return nil,  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return nil,  errors.New(response.Error)
+	}
+
+	
+    	return response.Result, nil
+	}
+
+func (svc *POAService) GetApprovals(ID string) ([]entity.Approval, error){
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.GetApprovals, dto.GetApprovalsRequest{ID: ID})
+	if err != nil{
+		return nil,  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Query(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return nil,  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return nil,  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.GetApprovalsResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return nil,  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return nil,  errors.New(response.Error)
+	}
+
+	
+    	return response.Result, nil
+	}
+
+func (svc *POAService) GetAuditTrail(ID string) ([]entity.AuditRecord, error){
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.GetAuditTrail, dto.GetAuditTrailRequest{ID: ID})
+	if err != nil{
+		return nil,  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Query(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return nil,  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return nil,  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.GetAuditTrailResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return nil,  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return nil,  errors.New(response.Error)
+	}
+
+	
+    	return response.Result, nil
+	}
+
+func (svc *POAService) FindAttorneys(Request *entity.POASearchRequest) (*entity.POAPage, error){
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.FindAttorneys, dto.FindAttorneysRequest{Request: Request})
+	if err != nil{
+		return nil,  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Query(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return nil,  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return nil,  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.FindAttorneysResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return nil,  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return nil,  errors.New(response.Error)
+	}
+
+	
+    	return response.Result, nil
+	}
 
 func NewPOAService(
 	chanProv context.ChannelProvider,
@@ -0,0 +1,97 @@
+package proxy
+
+import(
+	"github.com/procsy-tech/attorney/dto"
+	"github.com/procsy-tech/attorney/entity"
+    "github.com/procsy-tech/attorney/api"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/context"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/retry"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"encoding/json"
+	"fmt"
+	"errors"
+)
+
+type PolicyService struct {
+	channelClient   *channel.Client
+}
+
+
+func (svc *PolicyService) GetPolicy() (*entity.PolicyTable, error){
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.GetPolicy, dto.GetPolicyRequest{})
+	if err != nil{
+		return nil,  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Query(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return nil,  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return nil,  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.GetPolicyResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return nil,  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return nil,  errors.New(response.Error)
+	}
+
+	
+    	return response.Result, nil
+	}
+
+func (svc *PolicyService) UpdatePolicy(Policy *entity.PolicyTable) error{
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.UpdatePolicy, dto.UpdatePolicyRequest{Policy: Policy})
+	if err != nil{
+		return  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Execute(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.UpdatePolicyResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return  errors.New(response.Error)
+	}
+
+	
+	return nil
+    }
+
+func NewPolicyService(
+	chanProv context.ChannelProvider,
+) (*PolicyService, error) {
+	channelClient, err := channel.New(chanProv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create channel client: %s", err)
+	}
+	return &PolicyService{
+		channelClient: channelClient,
+	}, nil
+}
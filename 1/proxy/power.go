@@ -0,0 +1,196 @@
+package proxy
+
+import(
+	"github.com/procsy-tech/attorney/dto"
+	"github.com/procsy-tech/attorney/entity"
+    "github.com/procsy-tech/attorney/api"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/context"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/retry"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"encoding/json"
+	"fmt"
+	"errors"
+)
+
+type PowerService struct {
+	channelClient   *channel.Client
+}
+
+
+func (svc *PowerService) CreatePower(Power *entity.PowerDefinition) error{
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.CreatePower, dto.CreatePowerRequest{Power: Power})
+	if err != nil{
+		return  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Execute(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.CreatePowerResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return  errors.New(response.Error)
+	}
+
+	
+	return nil
+    }
+
+func (svc *PowerService) UpdatePower(Power *entity.PowerDefinition) error{
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.UpdatePower, dto.UpdatePowerRequest{Power: Power})
+	if err != nil{
+		return  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Execute(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.UpdatePowerResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return  errors.New(response.Error)
+	}
+
+	
+	return nil
+    }
+
+func (svc *PowerService) DeletePower(Code string) error{
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.DeletePower, dto.DeletePowerRequest{Code: Code})
+	if err != nil{
+		return  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Execute(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.DeletePowerResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return  errors.New(response.Error)
+	}
+
+	
+	return nil
+    }
+
+func (svc *PowerService) GetPower(Code string) (*entity.PowerDefinition, error){
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.GetPower, dto.GetPowerRequest{Code: Code})
+	if err != nil{
+		return nil,  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Query(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return nil,  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return nil,  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.GetPowerResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return nil,  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return nil,  errors.New(response.Error)
+	}
+
+	
+    	return response.Result, nil
+	}
+
+func (svc *PowerService) ListPowers() ([]entity.PowerDefinition, error){
+	ccRequest,err := MakeChaincodeTransMapRequest("attorney", []*fab.ChaincodeCall{
+			{ID: "attorney"},
+		}, api.ListPowers, dto.ListPowersRequest{})
+	if err != nil{
+		return nil,  fmt.Errorf("error creating ccRequest: %s", err)
+	}
+	var ccResponse channel.Response
+	
+		ccResponse, err = svc.channelClient.Query(ccRequest, channel.WithRetry(retry.DefaultChannelOpts))
+		if err != nil {
+			return nil,  fmt.Errorf("failed to execute: %s", err)
+		}
+	
+
+	if ccResponse.ChaincodeStatus != 200 {
+		return nil,  errors.New(string(ccResponse.Payload))
+	}
+
+	var response dto.ListPowersResponse
+	err = json.Unmarshal(ccResponse.Payload, &response)
+	if err != nil {
+		return nil,  fmt.Errorf("failed to parse response payload: %s", err)
+	}
+
+	if len(response.Error) != 0{
+		return nil,  errors.New(response.Error)
+	}
+
+	
+    	return response.Result, nil
+	}
+
+func NewPowerService(
+	chanProv context.ChannelProvider,
+) (*PowerService, error) {
+	channelClient, err := channel.New(chanProv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create channel client: %s", err)
+	}
+	return &PowerService{
+		channelClient: channelClient,
+	}, nil
+}
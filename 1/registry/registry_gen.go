@@ -4,6 +4,7 @@ import (
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 	"github.com/procsy-tech/attorney/service"
 	"github.com/procsy-tech/attorney/repository"
+	"github.com/procsy-tech/attorney/txcontext"
 	"github.com/procsy-tech/attorney/utils/logs"
 )
 
@@ -11,23 +12,59 @@ type (
 	// ServiceLocator .
 	ServiceLocator interface {
 			POAService() service.POAService
+			PowerService() service.PowerService
+			PolicyService() service.PolicyService
+			TrustAnchorService() service.TrustAnchorService
 
 		Logger() logs.Logger
 		Repository() repository.Repository
+		Clock() txcontext.Clock
+		Identity() txcontext.Identity
+		Transaction() txcontext.Transaction
 	}
 
 	serviceLocatorImpl struct {
 		stub shim.ChaincodeStubInterface
+		// seq counts documents created in the transaction, the locator lives for a single transaction.
+		seq int
 	}
 )
 
 var (
 	POAServiceLog   = shim.NewLogger("POAService")
+	PowerServiceLog   = shim.NewLogger("PowerService")
+	PolicyServiceLog   = shim.NewLogger("PolicyService")
+	TrustAnchorServiceLog   = shim.NewLogger("TrustAnchorService")
 )
 func (sl *serviceLocatorImpl) POAService() service.POAService {
 	return service.NewPOAServiceImpl(
 		POAServiceLog,
 		sl.Repository(),
+		sl.Clock(),
+		sl.Identity(),
+		sl.Transaction(),
+		)
+}
+
+func (sl *serviceLocatorImpl) PowerService() service.PowerService {
+	return service.NewPowerServiceImpl(
+		PowerServiceLog,
+		sl.Repository(),
+		)
+}
+
+func (sl *serviceLocatorImpl) PolicyService() service.PolicyService {
+	return service.NewPolicyServiceImpl(
+		PolicyServiceLog,
+		sl.Repository(),
+		sl.Identity(),
+		)
+}
+
+func (sl *serviceLocatorImpl) TrustAnchorService() service.TrustAnchorService {
+	return service.NewTrustAnchorServiceImpl(
+		TrustAnchorServiceLog,
+		sl.Repository(),
 		)
 }
 
@@ -36,9 +73,21 @@ func (sl *serviceLocatorImpl) Logger() logs.Logger {
 }
 
 func (sl *serviceLocatorImpl) Repository() repository.Repository {
-	return repository.NewRepositoryImpl(shim.NewLogger("Repository"), sl.stub)
+	return repository.NewRepositoryImpl(shim.NewLogger("Repository"), sl.stub, sl.Clock(), &sl.seq)
+}
+
+func (sl *serviceLocatorImpl) Clock() txcontext.Clock {
+	return txcontext.NewClock(sl.stub)
+}
+
+func (sl *serviceLocatorImpl) Identity() txcontext.Identity {
+	return txcontext.NewIdentity(sl.stub)
+}
+
+func (sl *serviceLocatorImpl) Transaction() txcontext.Transaction {
+	return txcontext.NewTransaction(sl.stub)
 }
 
 func NewServiceLocatorImpl(stub shim.ChaincodeStubInterface) ServiceLocator {
-	return &serviceLocatorImpl{stub}
+	return &serviceLocatorImpl{stub: stub}
 }
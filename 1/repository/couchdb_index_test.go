@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/repository/mango"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// couchDBIndexDir holds CouchDB indexes packaged with the chaincode.
+const couchDBIndexDir = "../META-INF/statedb/couchdb/indexes"
+
+type couchDBIndex struct {
+	Index struct {
+		Fields []string `json:"fields"`
+	} `json:"index"`
+	DDoc string `json:"ddoc"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+func readCouchDBIndexes() ([]couchDBIndex, error) {
+	files, err := filepath.Glob(filepath.Join(couchDBIndexDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	var indexes []couchDBIndex
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var index couchDBIndex
+		err = json.Unmarshal(data, &index)
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, nil
+}
+
+// indexableOperators compare field values, CouchDB may answer them using an index.
+var indexableOperators = []string{"$eq", "$gt", "$gte", "$lt", "$lte"}
+
+// isIndexable reports whether the selector field is compared by an indexable operator.
+func isIndexable(selector mango.Selector, field string) bool {
+	condition, ok := selector[field].(mango.Selector)
+	if !ok {
+		return false
+	}
+	for _, operator := range indexableOperators {
+		if _, found := condition[operator]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// coveringIndex returns the most specific index CouchDB may use for the selector:
+// every index field must be compared by an indexable operator.
+func coveringIndex(indexes []couchDBIndex, selector mango.Selector) *couchDBIndex {
+	var found *couchDBIndex
+	for i, index := range indexes {
+		covered := true
+		for _, field := range index.Index.Fields {
+			if !isIndexable(selector, field) {
+				covered = false
+				break
+			}
+		}
+		if covered && (found == nil || len(index.Index.Fields) > len(found.Index.Fields)) {
+			found = &indexes[i]
+		}
+	}
+	return found
+}
+
+func TestCouchDBIndexes(t *testing.T) {
+	Convey("CouchDB indexes", t, func(c C) {
+		indexes, err := readCouchDBIndexes()
+		So(err, ShouldBeNil)
+		So(indexes, ShouldNotBeEmpty)
+
+		c.Convey("Index definitions should be valid", func(c C) {
+			names := make(map[string]bool)
+			for _, index := range indexes {
+				So(index.Type, ShouldEqual, "json")
+				So(index.Name, ShouldNotBeEmpty)
+				So(index.DDoc, ShouldNotBeEmpty)
+				So(index.Index.Fields, ShouldNotBeEmpty)
+				So(index.Index.Fields[0], ShouldEqual, "type")
+				So(names[index.Name], ShouldBeFalse)
+				names[index.Name] = true
+			}
+		})
+
+		value := "x"
+		validAt := "2026-06-01T12:00:00Z"
+		state := entity.POAState(entity.POAStateConfirmed)
+		requests := map[string]*entity.POASearchRequest{
+			"all":                     {},
+			"state":                   {State: &state},
+			"date_from":               {DateFrom: &value},
+			"date_to":                 {DateTo: &value},
+			"authority_inn":           {AuthorityINN: &value},
+			"principal_inn":           {PrincipalINN: &value},
+			"parent_id":               {ParentID: &value},
+			"state and principal_inn": {State: &state, PrincipalINN: &value},
+			"date_from_range":         {DateFromRange: &entity.DateRange{From: "2026-01-01", To: "2026-12-31"}},
+			"date_to_range":           {DateToRange: &entity.DateRange{From: "2026-01-01"}},
+			"valid_at":                {ValidAt: &validAt},
+		}
+		for name, req := range requests {
+			name, req := name, req
+			c.Convey("Selector by "+name+" should be covered by an index", func(c C) {
+				So(isCompositeIndexSearch(req), ShouldBeFalse)
+				query, err := poaQuery(req)
+				So(err, ShouldBeNil)
+				index := coveringIndex(indexes, query.Selector)
+				So(index, ShouldNotBeNil)
+				if name != "all" {
+					// the type index alone scans every POA
+					So(len(index.Index.Fields), ShouldBeGreaterThan, 1)
+				}
+			})
+		}
+
+		// CouchDB json indexes do not index array elements, these requests are answered by composite key indexes
+		compositeRequests := map[string]*entity.POASearchRequest{
+			"representative_inn":           {RepresentativeINN: &value},
+			"state and representative_inn": {State: &state, RepresentativeINN: &value},
+		}
+		for name, req := range compositeRequests {
+			name, req := name, req
+			c.Convey("Search by "+name+" should use composite key index", func(c C) {
+				So(isCompositeIndexSearch(req), ShouldBeTrue)
+			})
+		}
+	})
+}
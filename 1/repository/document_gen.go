@@ -2,9 +2,8 @@ package repository
 
 import (
 	"github.com/procsy-tech/attorney/entity"
-	"time"
+	"github.com/procsy-tech/attorney/txcontext"
 	"fmt"
-	"encoding/json"
 	"crypto/sha256"
 	"strings"
 )
@@ -16,6 +15,10 @@ type (
 
 
 const (POADocumentType = "POA"
+	PowerDocumentType = "Power"
+	PolicyDocumentType = "Policy"
+	TrustAnchorDocumentType = "TrustAnchor"
+	SettingsDocumentType = "Settings"
 	)
 
 
@@ -30,16 +33,21 @@ type POADocument struct{
 	}
 	
 
-func NewPOADocument(e *entity.POA) POADocument{
-		timestamp := time.Now().Unix()
-		eData, _ := json.Marshal(e)
-		h := sha256.Sum256(eData)
-		e.BlockchainID = "POA" + fmt.Sprintf("%d", timestamp) + strings.ToUpper(fmt.Sprintf("%x", h[0:4]))
+// NewPOADocument assigns blockchain id to the entity. The id is built from the transaction time,
+// the transaction id and the number of the document within the transaction,
+// so every endorsing peer assigns the same id.
+func NewPOADocument(e *entity.POA, clock txcontext.Clock, txID string, seq int) (POADocument, error){
+		now, err := clock.Now()
+		if err != nil {
+			return POADocument{}, err
+		}
+		h := sha256.Sum256([]byte(fmt.Sprintf("%s:%d", txID, seq)))
+		e.BlockchainID = "POA" + fmt.Sprintf("%d", now.Unix()) + strings.ToUpper(fmt.Sprintf("%x", h[0:4]))
 		return POADocument{
 			Document{
 				Type: POADocumentType,
 			},
 			*e,
-		}
+		}, nil
 	}
 	
\ No newline at end of file
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/kbkontrakt/hlfabric-ccdevkit/logs"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewPOADocument(t *testing.T) {
+	Convey("Given POA", t, func(c C) {
+		c.Convey("It should derive the same id from the same transaction and sequence number", func(c C) {
+			first, err := NewPOADocument(testPOA(), txClock, "tx1", 0)
+			So(err, ShouldBeNil)
+			second, err := NewPOADocument(testPOA(), txClock, "tx1", 0)
+			So(err, ShouldBeNil)
+			So(first.BlockchainID, ShouldEqual, second.BlockchainID)
+			So(first.BlockchainID, ShouldStartWith, "POA1622548800")
+			So(first.Type, ShouldEqual, POADocumentType)
+		})
+		c.Convey("It should derive distinct ids for distinct sequence numbers and transactions", func(c C) {
+			first, err := NewPOADocument(testPOA(), txClock, "tx1", 0)
+			So(err, ShouldBeNil)
+			second, err := NewPOADocument(testPOA(), txClock, "tx1", 1)
+			So(err, ShouldBeNil)
+			other, err := NewPOADocument(testPOA(), txClock, "tx2", 0)
+			So(err, ShouldBeNil)
+			So(second.BlockchainID, ShouldNotEqual, first.BlockchainID)
+			So(other.BlockchainID, ShouldNotEqual, first.BlockchainID)
+		})
+	})
+}
+
+func TestPOARepositoryNew(t *testing.T) {
+	Convey("Given repository of the transaction", t, func(c C) {
+		stub := newTestStub()
+		var seq int
+		rep := NewRepositoryImpl(logs.DummyLogger(), stub, txClock, &seq)
+
+		c.Convey("When two POAs are created in the transaction", func(c C) {
+			first, err := rep.POARepository().New(testPOA())
+			So(err, ShouldBeNil)
+			second, err := rep.POARepository().New(testPOA())
+			So(err, ShouldBeNil)
+
+			c.Convey("It should assign them distinct ids", func(c C) {
+				So(second, ShouldNotEqual, first)
+				e, err := rep.POARepository().GetByBlockchainID(first)
+				So(err, ShouldBeNil)
+				So(e.BlockchainID, ShouldEqual, first)
+			})
+		})
+		c.Convey("When the key of the new POA is present", func(c C) {
+			document, err := NewPOADocument(testPOA(), txClock, "tx1", 0)
+			So(err, ShouldBeNil)
+			So(stub.PutState(document.BlockchainID, []byte(`{"type":"POA"}`)), ShouldBeNil)
+
+			c.Convey("It should return exists error", func(c C) {
+				_, err := rep.POARepository().New(testPOA())
+				So(err, ShouldEqual, ErrPOAExists)
+			})
+		})
+	})
+}
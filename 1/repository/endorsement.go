@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/core/chaincode/shim/ext/statebased"
+)
+
+// setKeyEndorsement sets key-level endorsement policy requiring peers of every organization
+// to endorse later changes of the key. For private data stub the policy is set on the collection key.
+// Does nothing without organizations, leaving the chaincode-wide policy in effect.
+func setKeyEndorsement(stub shim.ChaincodeStubInterface, key string, mspIDs []string) error {
+	if len(mspIDs) == 0 {
+		return nil
+	}
+
+	ep, err := statebased.NewStateEP(nil)
+	if err != nil {
+		return err
+	}
+	err = ep.AddOrgs(statebased.RoleTypePeer, mspIDs...)
+	if err != nil {
+		return fmt.Errorf("failed to build endorsement policy: %s", err)
+	}
+	policy, err := ep.Policy()
+	if err != nil {
+		return fmt.Errorf("failed to build endorsement policy: %s", err)
+	}
+
+	err = stub.SetStateValidationParameter(key, policy)
+	if err != nil {
+		return fmt.Errorf("failed to set endorsement policy of %s: %s", key, err)
+	}
+	return nil
+}
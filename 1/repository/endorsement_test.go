@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim/ext/statebased"
+	"github.com/procsy-tech/attorney/entity"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// endorsingOrgs returns organizations required by key-level endorsement policy, nil without policy.
+func endorsingOrgs(policy []byte) []string {
+	if policy == nil {
+		return nil
+	}
+	ep, err := statebased.NewStateEP(policy)
+	So(err, ShouldBeNil)
+	orgs := ep.ListOrgs()
+	sort.Strings(orgs)
+	return orgs
+}
+
+func TestPOAKeyEndorsement(t *testing.T) {
+	Convey("Given POA repository", t, func(c C) {
+		stub := newTestStub()
+		rep := newTestPOARepository(stub)
+
+		c.Convey("When POA is created", func(c C) {
+			e := testPOA()
+			id, err := rep.New(e)
+			So(err, ShouldBeNil)
+
+			c.Convey("It should require endorsement of the principal's and the representative's organizations", func(c C) {
+				policy, err := stub.GetStateValidationParameter(id)
+				So(err, ShouldBeNil)
+				So(endorsingOrgs(policy), ShouldResemble, []string{"Org1MSP", "Org2MSP"})
+			})
+			c.Convey("It should keep both organizations while POA is sent", func(c C) {
+				e.State = entity.POAStateSent
+				So(rep.Update(e), ShouldBeNil)
+				policy, err := stub.GetStateValidationParameter(id)
+				So(err, ShouldBeNil)
+				So(endorsingOrgs(policy), ShouldResemble, []string{"Org1MSP", "Org2MSP"})
+			})
+			c.Convey("When POA is confirmed", func(c C) {
+				e.State = entity.POAStateConfirmed
+				So(rep.Update(e), ShouldBeNil)
+
+				c.Convey("It should require endorsement of the principal's organization only", func(c C) {
+					policy, err := stub.GetStateValidationParameter(id)
+					So(err, ShouldBeNil)
+					So(endorsingOrgs(policy), ShouldResemble, []string{"Org1MSP"})
+				})
+				c.Convey("It should keep the principal's organization once POA is revoked", func(c C) {
+					e.State = entity.POAStateRevoked
+					So(rep.Update(e), ShouldBeNil)
+					policy, err := stub.GetStateValidationParameter(id)
+					So(err, ShouldBeNil)
+					So(endorsingOrgs(policy), ShouldResemble, []string{"Org1MSP"})
+				})
+			})
+		})
+		c.Convey("When POA without principal's organization is created", func(c C) {
+			e := testPOA()
+			e.PrincipalMSPID = ""
+			id, err := rep.New(e)
+			So(err, ShouldBeNil)
+
+			c.Convey("It should leave the chaincode endorsement policy in effect", func(c C) {
+				policy, err := stub.GetStateValidationParameter(id)
+				So(err, ShouldBeNil)
+				So(policy, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given POA repository on private data", t, func(c C) {
+		stub := newTestStub()
+		rep := newTestPOARepository(NewPrivateStubDecorator(attorneyCollectionName, stub))
+
+		c.Convey("When POA is created and confirmed", func(c C) {
+			e := testPOA()
+			id, err := rep.New(e)
+			So(err, ShouldBeNil)
+
+			policy, err := stub.GetPrivateDataValidationParameter(attorneyCollectionName, id)
+			So(err, ShouldBeNil)
+			So(endorsingOrgs(policy), ShouldResemble, []string{"Org1MSP", "Org2MSP"})
+
+			e.State = entity.POAStateConfirmed
+			So(rep.Update(e), ShouldBeNil)
+
+			c.Convey("It should set the policy on the collection key", func(c C) {
+				policy, err := stub.GetPrivateDataValidationParameter(attorneyCollectionName, id)
+				So(err, ShouldBeNil)
+				So(endorsingOrgs(policy), ShouldResemble, []string{"Org1MSP"})
+			})
+			c.Convey("It should not set the policy on the public key", func(c C) {
+				policy, err := stub.GetStateValidationParameter(id)
+				So(err, ShouldBeNil)
+				So(policy, ShouldBeNil)
+			})
+		})
+	})
+}
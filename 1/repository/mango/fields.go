@@ -0,0 +1,88 @@
+package mango
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Fields resolves Go field paths of a document struct into names of the stored JSON fields.
+type Fields struct {
+	t reflect.Type
+}
+
+// FieldsOf returns fields of the document, which is a struct or a pointer to a struct.
+func FieldsOf(document interface{}) *Fields {
+	return &Fields{indirect(reflect.TypeOf(document))}
+}
+
+// Name returns JSON name of the field path, e.g. "Principal.INN" is stored as "principal.inn".
+func (f *Fields) Name(path string) (string, error) {
+	name, _, err := f.resolve(path)
+	return name, err
+}
+
+// resolve returns JSON name and type of the field path.
+func (f *Fields) resolve(path string) (string, reflect.Type, error) {
+	if len(path) == 0 {
+		return "", nil, fmt.Errorf("empty field path")
+	}
+
+	t := f.t
+	var names []string
+	for _, part := range strings.Split(path, ".") {
+		if t.Kind() != reflect.Struct {
+			return "", nil, fmt.Errorf("field %s: %s is not a struct", path, t)
+		}
+		name, field, ok := lookup(t, part)
+		if !ok {
+			return "", nil, fmt.Errorf("field %s: %s has no stored field %s", path, t, part)
+		}
+		names = append(names, name)
+		t = indirect(field)
+	}
+
+	return strings.Join(names, "."), t, nil
+}
+
+// lookup finds the field by Go name among fields of the struct and of its embedded structs,
+// as encoding/json does, and returns its JSON name.
+func lookup(t reflect.Type, goName string) (string, reflect.Type, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, skip := jsonName(field)
+		if skip {
+			continue
+		}
+		if field.Anonymous && name == "" && indirect(field.Type).Kind() == reflect.Struct {
+			if name, ft, ok := lookup(indirect(field.Type), goName); ok {
+				return name, ft, true
+			}
+			continue
+		}
+		if field.Name != goName || field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		return name, field.Type, true
+	}
+	return "", nil, false
+}
+
+// jsonName returns the name from the json tag, skip is set for fields which are not stored.
+func jsonName(field reflect.StructField) (name string, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	return strings.Split(tag, ",")[0], false
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,255 @@
+// Package mango builds CouchDB Mango queries naming fields after Go fields of the stored documents.
+package mango
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+type (
+	// Selector is a Mango selector.
+	Selector map[string]interface{}
+
+	// Condition is a part of the selector, its fields are resolved when the query is built.
+	Condition interface {
+		selector(fields *Fields) (Selector, error)
+	}
+
+	// Query is a Mango query ready to be passed to GetQueryResult.
+	Query struct {
+		Selector Selector            `json:"selector"`
+		Fields   []string            `json:"fields,omitempty"`
+		Sort     []map[string]string `json:"sort,omitempty"`
+	}
+
+	// Builder composes the query of conditions on the document fields.
+	Builder struct {
+		fields     *Fields
+		conditions []Condition
+		sort       []sortField
+		projection []string
+	}
+
+	sortField struct {
+		path      string
+		direction string
+	}
+
+	fieldCondition struct {
+		path     string
+		operator string
+		value    interface{}
+	}
+
+	elemMatchCondition struct {
+		path       string
+		conditions []Condition
+	}
+
+	logicalCondition struct {
+		operator   string
+		conditions []Condition
+	}
+)
+
+// Eq matches documents whose field equals the value.
+func Eq(path string, value interface{}) Condition {
+	return &fieldCondition{path, "$eq", value}
+}
+
+// Gt matches documents whose field is greater than the value.
+func Gt(path string, value interface{}) Condition {
+	return &fieldCondition{path, "$gt", value}
+}
+
+// Gte matches documents whose field is greater than or equal to the value.
+func Gte(path string, value interface{}) Condition {
+	return &fieldCondition{path, "$gte", value}
+}
+
+// Lt matches documents whose field is less than the value.
+func Lt(path string, value interface{}) Condition {
+	return &fieldCondition{path, "$lt", value}
+}
+
+// Lte matches documents whose field is less than or equal to the value.
+func Lte(path string, value interface{}) Condition {
+	return &fieldCondition{path, "$lte", value}
+}
+
+// In matches documents whose field equals one of the values.
+func In(path string, values ...interface{}) Condition {
+	return &fieldCondition{path, "$in", values}
+}
+
+// Regex matches documents whose string field matches the regular expression.
+func Regex(path string, pattern string) Condition {
+	return &fieldCondition{path, "$regex", pattern}
+}
+
+// ElemMatch matches documents whose array field has an element satisfying all conditions,
+// paths of the conditions are relative to the element.
+func ElemMatch(path string, conditions ...Condition) Condition {
+	return &elemMatchCondition{path, conditions}
+}
+
+// And matches documents satisfying all conditions.
+func And(conditions ...Condition) Condition {
+	return &logicalCondition{"$and", conditions}
+}
+
+// Or matches documents satisfying any of conditions.
+func Or(conditions ...Condition) Condition {
+	return &logicalCondition{"$or", conditions}
+}
+
+func (c *fieldCondition) selector(fields *Fields) (Selector, error) {
+	name, t, err := fields.resolve(c.path)
+	if err != nil {
+		return nil, err
+	}
+	if c.operator == "$regex" && t.Kind() != reflect.String {
+		return nil, fmt.Errorf("field %s: $regex needs a string field", c.path)
+	}
+	return Selector{name: Selector{c.operator: c.value}}, nil
+}
+
+func (c *elemMatchCondition) selector(fields *Fields) (Selector, error) {
+	name, t, err := fields.resolve(c.path)
+	if err != nil {
+		return nil, err
+	}
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+		return nil, fmt.Errorf("field %s: $elemMatch needs an array field", c.path)
+	}
+	element, err := And(c.conditions...).selector(&Fields{indirect(t.Elem())})
+	if err != nil {
+		return nil, err
+	}
+	return Selector{name: Selector{"$elemMatch": element}}, nil
+}
+
+// selector of $and merges conditions into one selector, which CouchDB matches to indexes best,
+// unless they repeat an operator on the same field. Then it falls back to the $and array.
+func (c *logicalCondition) selector(fields *Fields) (Selector, error) {
+	selectors := make([]interface{}, 0, len(c.conditions))
+	merged := Selector{}
+	mergeable := c.operator == "$and"
+	for _, condition := range c.conditions {
+		selector, err := condition.selector(fields)
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+		for name, value := range selector {
+			if name[0] == '$' {
+				mergeable = false
+				continue
+			}
+			if existing, found := merged[name]; found {
+				value = mergeOperators(existing, value)
+				if value == nil {
+					mergeable = false
+					continue
+				}
+			}
+			merged[name] = value
+		}
+	}
+	if mergeable {
+		return merged, nil
+	}
+	if len(selectors) == 0 {
+		return nil, fmt.Errorf("%s needs conditions", c.operator)
+	}
+	return Selector{c.operator: selectors}, nil
+}
+
+// mergeOperators merges operators of two conditions on the same field,
+// it returns nil if either is not an operator map or they share an operator.
+func mergeOperators(a, b interface{}) Selector {
+	x, ok := a.(Selector)
+	if !ok {
+		return nil
+	}
+	y, ok := b.(Selector)
+	if !ok {
+		return nil
+	}
+	merged := Selector{}
+	for operator, value := range x {
+		merged[operator] = value
+	}
+	for operator, value := range y {
+		if _, found := merged[operator]; found {
+			return nil
+		}
+		merged[operator] = value
+	}
+	return merged
+}
+
+// For starts the query of documents of the type, document is a struct or a pointer to a struct.
+func For(document interface{}) *Builder {
+	return &Builder{fields: FieldsOf(document)}
+}
+
+// Where adds conditions, the query matches documents satisfying all of them.
+func (b *Builder) Where(conditions ...Condition) *Builder {
+	b.conditions = append(b.conditions, conditions...)
+	return b
+}
+
+// SortAsc sorts results by the field in ascending order.
+func (b *Builder) SortAsc(path string) *Builder {
+	b.sort = append(b.sort, sortField{path, "asc"})
+	return b
+}
+
+// SortDesc sorts results by the field in descending order.
+func (b *Builder) SortDesc(path string) *Builder {
+	b.sort = append(b.sort, sortField{path, "desc"})
+	return b
+}
+
+// Select limits the fields returned, all fields are returned by default.
+func (b *Builder) Select(paths ...string) *Builder {
+	b.projection = append(b.projection, paths...)
+	return b
+}
+
+// Build resolves field names and returns the query.
+func (b *Builder) Build() (*Query, error) {
+	selector, err := And(b.conditions...).selector(b.fields)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &Query{Selector: selector}
+	for _, path := range b.projection {
+		name, err := b.fields.Name(path)
+		if err != nil {
+			return nil, err
+		}
+		query.Fields = append(query.Fields, name)
+	}
+	for _, field := range b.sort {
+		name, err := b.fields.Name(field.path)
+		if err != nil {
+			return nil, err
+		}
+		query.Sort = append(query.Sort, map[string]string{name: field.direction})
+	}
+
+	return query, nil
+}
+
+// JSON returns the query as passed to the ledger.
+func (q *Query) JSON() (string, error) {
+	data, err := json.Marshal(q)
+	if err != nil {
+		return "", fmt.Errorf("failed to format query: %s", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,99 @@
+package mango
+
+import (
+	"testing"
+
+	"github.com/procsy-tech/attorney/entity"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// testDocument is stored like repository documents: the type and the embedded entity.
+type testDocument struct {
+	Type string `json:"type"`
+	entity.POA
+	Secret string `json:"-"`
+}
+
+func TestFields(t *testing.T) {
+	Convey("Fields", t, func(c C) {
+		fields := FieldsOf(&testDocument{})
+
+		c.Convey("It should name fields after json tags", func(c C) {
+			for path, expected := range map[string]string{
+				"Type":          "type",
+				"State":         "state",
+				"DateFrom":      "date_from",
+				"AuthorityINN":  "authority_inn",
+				"Principal.INN": "principal.inn",
+				"BlockchainID":  "BlockchainID",
+			} {
+				name, err := fields.Name(path)
+				So(err, ShouldBeNil)
+				So(name, ShouldEqual, expected)
+			}
+		})
+		c.Convey("It should reject fields which are not stored", func(c C) {
+			for _, path := range []string{"", "state", "Secret", "Principal.Unknown", "State.Value", "Representatives.INN"} {
+				_, err := fields.Name(path)
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
+
+func TestBuilder(t *testing.T) {
+	Convey("Builder", t, func(c C) {
+		c.Convey("When conditions are on distinct fields", func(c C) {
+			query, err := For(testDocument{}).
+				Where(Eq("Type", "POA"), In("State", "Sent", "Confirmed")).
+				Where(ElemMatch("Representatives", Eq("INN", "7707083893"))).
+				Build()
+			c.Convey("It should merge them into one selector", func(c C) {
+				So(err, ShouldBeNil)
+				data, err := query.JSON()
+				So(err, ShouldBeNil)
+				So(data, ShouldEqual, `{"selector":{"representatives":{"$elemMatch":{"inn":{"$eq":"7707083893"}}},`+
+					`"state":{"$in":["Sent","Confirmed"]},"type":{"$eq":"POA"}}}`)
+			})
+		})
+		c.Convey("When conditions bound the field", func(c C) {
+			query, err := For(testDocument{}).
+				Where(Gte("DateFrom", "2021-01-01"), Lt("DateFrom", "2022-01-01")).
+				Build()
+			c.Convey("It should merge the operators", func(c C) {
+				So(err, ShouldBeNil)
+				data, err := query.JSON()
+				So(err, ShouldBeNil)
+				So(data, ShouldEqual, `{"selector":{"date_from":{"$gte":"2021-01-01","$lt":"2022-01-01"}}}`)
+			})
+		})
+		c.Convey("When conditions repeat the operator on the field", func(c C) {
+			query, err := For(testDocument{}).
+				Where(Gt("DateFrom", "2021-01-01"), Gt("DateFrom", "2022-01-01")).
+				Where(Or(Regex("AuthorityINN", "^77"), Eq("Principal.INN", "7707083893"))).
+				SortDesc("DateFrom").
+				Select("Type", "State").
+				Build()
+			c.Convey("It should compose them with $and", func(c C) {
+				So(err, ShouldBeNil)
+				data, err := query.JSON()
+				So(err, ShouldBeNil)
+				So(data, ShouldEqual, `{"selector":{"$and":[{"date_from":{"$gt":"2021-01-01"}},{"date_from":{"$gt":"2022-01-01"}},`+
+					`{"$or":[{"authority_inn":{"$regex":"^77"}},{"principal.inn":{"$eq":"7707083893"}}]}]},`+
+					`"fields":["type","state"],"sort":[{"date_from":"desc"}]}`)
+			})
+		})
+		c.Convey("When condition does not fit the field", func(c C) {
+			c.Convey("It should return error", func(c C) {
+				_, err := For(testDocument{}).Where(Regex("AllowSubstitution", "true")).Build()
+				So(err, ShouldNotBeNil)
+				_, err = For(testDocument{}).Where(ElemMatch("State", Eq("INN", "1"))).Build()
+				So(err, ShouldNotBeNil)
+				_, err = For(testDocument{}).Where(Or()).Build()
+				So(err, ShouldNotBeNil)
+				_, err = For(testDocument{}).SortAsc("Unknown").Build()
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
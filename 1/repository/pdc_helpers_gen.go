@@ -52,21 +52,33 @@ func (s *privateStubDecorator) GetStateByRange(startKey, endKey string) (shim.St
 }
 func (s *privateStubDecorator) GetStateByRangeWithPagination(startKey, endKey string, pageSize int32,
 	bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
-	panic("not supported")
+	iterator, err := s.ChaincodeStubInterface.GetPrivateDataByRange(s.collectionName, startKey, endKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return paginate(iterator, pageSize, bookmark)
 }
 func (s *privateStubDecorator) GetStateByPartialCompositeKey(objectType string, keys []string) (shim.StateQueryIteratorInterface, error) {
 	return s.ChaincodeStubInterface.GetPrivateDataByPartialCompositeKey(s.collectionName, objectType, keys)
 }
 func (s *privateStubDecorator) GetStateByPartialCompositeKeyWithPagination(objectType string, keys []string,
 	pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
-	panic("not supported")
+	iterator, err := s.ChaincodeStubInterface.GetPrivateDataByPartialCompositeKey(s.collectionName, objectType, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return paginate(iterator, pageSize, bookmark)
 }
 func (s *privateStubDecorator) GetQueryResult(query string) (shim.StateQueryIteratorInterface, error) {
 	return s.ChaincodeStubInterface.GetPrivateDataQueryResult(s.collectionName, query)
 }
 func (s *privateStubDecorator) GetQueryResultWithPagination(query string, pageSize int32,
 	bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
-	panic("not supported")
+	iterator, err := s.ChaincodeStubInterface.GetPrivateDataQueryResult(s.collectionName, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	return paginate(iterator, pageSize, bookmark)
 }
 func (s *privateStubDecorator) GetHistoryForKey(key string) (shim.HistoryQueryIteratorInterface, error) {
 	return s.ChaincodeStubInterface.GetHistoryForKey(key)
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	qr "github.com/hyperledger/fabric/protos/ledger/queryresult"
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+// pageIterator iterates over records of a page read in advance.
+type pageIterator struct {
+	records []*qr.KV
+	inx     int
+}
+
+func (i *pageIterator) HasNext() bool {
+	return i.inx < len(i.records)
+}
+
+func (i *pageIterator) Next() (*qr.KV, error) {
+	if !i.HasNext() {
+		return nil, errors.New("no more records")
+	}
+	i.inx++
+	return i.records[i.inx-1], nil
+}
+
+func (i *pageIterator) Close() error {
+	return nil
+}
+
+// paginate reads the page following the bookmark from iterator of private data, which Fabric can not paginate.
+// The bookmark is the key of the last record of the previous page, records with keys up to it are skipped,
+// so records must be ordered by key. The record of the bookmark itself may be gone by the next call.
+// Page size which is not positive means all records.
+func paginate(iterator shim.StateQueryIteratorInterface, pageSize int32,
+	bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	defer iterator.Close()
+
+	page := new(pageIterator)
+
+	for iterator.HasNext() {
+		if pageSize > 0 && int32(len(page.records)) == pageSize {
+			break
+		}
+		record, err := iterator.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(bookmark) != 0 && record.Key <= bookmark {
+			continue
+		}
+		page.records = append(page.records, record)
+	}
+
+	metadata := &pb.QueryResponseMetadata{FetchedRecordsCount: int32(len(page.records))}
+	if len(page.records) != 0 {
+		metadata.Bookmark = page.records[len(page.records)-1].Key
+	}
+
+	return page, metadata, nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// readPage paginates records of the test object type and returns keys of the page and the bookmark.
+func readPage(stub *testStub, pageSize int32, bookmark string) ([]string, string, error) {
+	iterator, err := stub.GetStateByPartialCompositeKey("Test", []string{})
+	So(err, ShouldBeNil)
+	page, metadata, err := paginate(iterator, pageSize, bookmark)
+	if err != nil {
+		return nil, "", err
+	}
+	var keys []string
+	for page.HasNext() {
+		record, err := page.Next()
+		So(err, ShouldBeNil)
+		keys = append(keys, record.Key)
+	}
+	So(metadata.FetchedRecordsCount, ShouldEqual, len(keys))
+	return keys, metadata.Bookmark, nil
+}
+
+func TestPaginate(t *testing.T) {
+	Convey("Given seven records", t, func(c C) {
+		stub := newTestStub()
+		var keys []string
+		for inx := 0; inx < 7; inx++ {
+			key, err := stub.CreateCompositeKey("Test", []string{fmt.Sprint(inx)})
+			So(err, ShouldBeNil)
+			So(stub.PutState(key, []byte{0x00}), ShouldBeNil)
+			keys = append(keys, key)
+		}
+
+		c.Convey("It should return the first page without bookmark", func(c C) {
+			page, bookmark, err := readPage(stub, 3, "")
+			So(err, ShouldBeNil)
+			So(page, ShouldResemble, keys[0:3])
+			So(bookmark, ShouldEqual, keys[2])
+		})
+		c.Convey("It should return the middle page following the bookmark", func(c C) {
+			page, bookmark, err := readPage(stub, 3, keys[2])
+			So(err, ShouldBeNil)
+			So(page, ShouldResemble, keys[3:6])
+			So(bookmark, ShouldEqual, keys[5])
+		})
+		c.Convey("It should return the short last page and then an empty one", func(c C) {
+			page, bookmark, err := readPage(stub, 3, keys[5])
+			So(err, ShouldBeNil)
+			So(page, ShouldResemble, keys[6:])
+			So(bookmark, ShouldEqual, keys[6])
+
+			page, bookmark, err = readPage(stub, 3, keys[6])
+			So(err, ShouldBeNil)
+			So(page, ShouldBeEmpty)
+			So(bookmark, ShouldBeEmpty)
+		})
+		c.Convey("It should return all records when page size is not positive", func(c C) {
+			page, _, err := readPage(stub, 0, "")
+			So(err, ShouldBeNil)
+			So(page, ShouldResemble, keys)
+		})
+		c.Convey("It should continue after the bookmarked record deleted between pages", func(c C) {
+			_, bookmark, err := readPage(stub, 3, "")
+			So(err, ShouldBeNil)
+			So(stub.DelState(keys[2]), ShouldBeNil)
+
+			page, _, err := readPage(stub, 3, bookmark)
+			So(err, ShouldBeNil)
+			So(page, ShouldResemble, keys[3:6])
+		})
+	})
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/repository/mango"
+)
+
+// dateRangeConditions bounds the calendar date of the date field. ISO-8601 values sort as dates,
+// so the day after the upper bound is an exclusive bound of its date-times too.
+func dateRangeConditions(path string, r *entity.DateRange) ([]mango.Condition, error) {
+	var conditions []mango.Condition
+	if len(r.From) != 0 {
+		if _, err := time.Parse(entity.POADateLayout, r.From); err != nil {
+			return nil, fmt.Errorf("%q is not a date", r.From)
+		}
+		conditions = append(conditions, mango.Gte(path, r.From))
+	}
+	if len(r.To) != 0 {
+		to, err := time.Parse(entity.POADateLayout, r.To)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a date", r.To)
+		}
+		conditions = append(conditions, mango.Lt(path, to.AddDate(0, 0, 1).Format(entity.POADateLayout)))
+	}
+	return conditions, nil
+}
+
+// validAtConditions selects POAs which may be in force at the moment: confirmed or revoked ones.
+// String comparison ignores time zones of date-times, so the dates are widened by a day
+// and the moment is checked on loaded POAs, as well as the revocation time.
+func validAtConditions(validAt string) ([]mango.Condition, error) {
+	at, err := entity.ParsePOADate(validAt)
+	if err != nil {
+		return nil, err
+	}
+	return []mango.Condition{
+		mango.In("State", entity.POAStateConfirmed, entity.POAStateRevoked),
+		mango.Lt("DateFrom", at.AddDate(0, 0, 2).Format(entity.POADateLayout)),
+		mango.Gte("DateTo", at.AddDate(0, 0, -1).Format(entity.POADateLayout)),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/procsy-tech/attorney/entity"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPOAFindValidAt(t *testing.T) {
+	Convey("Given POAs in several states on peer without CouchDB", t, func(c C) {
+		stub := newTestStub()
+		rep := newTestPOARepository(stub)
+
+		confirmed := testPOA()
+		confirmed.State = entity.POAStateConfirmed
+		confirmedID, err := rep.New(confirmed)
+		So(err, ShouldBeNil)
+
+		_, err = rep.New(testPOA())
+		So(err, ShouldBeNil)
+
+		rejected := testPOA()
+		rejected.State = entity.POAStateRejected
+		_, err = rep.New(rejected)
+		So(err, ShouldBeNil)
+
+		revoked := testPOA()
+		revoked.State = entity.POAStateRevoked
+		revoked.Revocation = &entity.Revocation{Reason: "lost", Timestamp: "2021-06-01T12:00:00Z"}
+		revokedID, err := rep.New(revoked)
+		So(err, ShouldBeNil)
+
+		c.Convey("It should find confirmed POAs not revoked at the moment", func(c C) {
+			validAt := "2021-05-01"
+			page, err := rep.Find(&entity.POASearchRequest{ValidAt: &validAt})
+			So(err, ShouldBeNil)
+			So(blockchainIDs(page), ShouldHaveLength, 2)
+			So(blockchainIDs(page), ShouldContain, confirmedID)
+			So(blockchainIDs(page), ShouldContain, revokedID)
+		})
+		c.Convey("It should exclude POAs revoked before the moment", func(c C) {
+			validAt := "2021-06-01T12:00:00Z"
+			page, err := rep.Find(&entity.POASearchRequest{ValidAt: &validAt})
+			So(err, ShouldBeNil)
+			So(blockchainIDs(page), ShouldResemble, []string{confirmedID})
+		})
+		c.Convey("It should exclude POAs expired at the moment", func(c C) {
+			validAt := "2022-01-01"
+			page, err := rep.Find(&entity.POASearchRequest{ValidAt: &validAt})
+			So(err, ShouldBeNil)
+			So(page.POAs, ShouldBeEmpty)
+		})
+	})
+}
@@ -4,13 +4,18 @@ import (
 	"fmt"
 	"errors"
 	"encoding/json"
+	"time"
 	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/repository/mango"
+	"github.com/procsy-tech/attorney/txcontext"
 	"github.com/procsy-tech/attorney/utils/logs"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
 )
 
 var (
 	ErrPOANotFound = errors.New("POA not found")
+	ErrPOAExists = errors.New("POA already exists")
 )
 
 type (
@@ -20,21 +25,37 @@ type (
 		Update(*entity.POA) error
         DeleteByBlockchainID(string) error
         HistoryByBlockchainID(string) ([]entity.POA, error)
-        FindItem(string) (*entity.POA, error)
-        Find(*entity.POASearchRequest) ([]entity.POA, error)
-        List() ([]entity.POA, error)
+        GetByBlockchainIDAt(string, time.Time) (*entity.POA, error)
+        FindItem(*mango.Query) (*entity.POA, error)
+        Find(*entity.POASearchRequest) (*entity.POAPage, error)
+        List(int32, string) (*entity.POAPage, error)
 	}
 
 	POARepositoryImpl struct {
 		log logs.Logger
 		stub shim.ChaincodeStubInterface
+		clock txcontext.Clock
+		// seq counts documents created in the transaction.
+		seq *int
 	}
 )
 
 func (rep *POARepositoryImpl) New(e *entity.POA) (string, error) {
 	log := logs.WithTags(rep.log, "method", "New")
 
-	document := NewPOADocument(e)
+	document, err := NewPOADocument(e, rep.clock, rep.stub.GetTxID(), *rep.seq)
+	if err != nil {
+		return "", err
+	}
+	*rep.seq++
+
+	existing, err := rep.stub.GetState(document.BlockchainID)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		return "", ErrPOAExists
+	}
 
 	log.Infof("created entity POA with id %s", document.BlockchainID)
 
@@ -48,35 +69,25 @@ func (rep *POARepositoryImpl) New(e *entity.POA) (string, error) {
 		return "", err
 	}
 
-	return document.BlockchainID, nil
-}
-
-func (rep *POARepositoryImpl) GetByBlockchainID(blockchainID string) (*entity.POA, error) {
-	log := logs.WithTags(rep.log, "method", "GetByBlockchainID")
-	
-	log.Infof("searching entity by id %s", blockchainID)
-
-	data, err := rep.stub.GetState(blockchainID)
+	err = rep.reindex(nil, e)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	if data == nil {
-		return nil, ErrPOANotFound
+	err = setKeyEndorsement(rep.stub, document.BlockchainID, e.EndorsingMSPIDs())
+	if err != nil {
+		return "", err
 	}
 
-	document := new(POADocument)
+	return document.BlockchainID, nil
+}
 
-	err = json.Unmarshal(data, document)
-	if err != nil {
-		return nil, err
-	}
+func (rep *POARepositoryImpl) GetByBlockchainID(blockchainID string) (*entity.POA, error) {
+	log := logs.WithTags(rep.log, "method", "GetByBlockchainID")
 	
-	if document.Type != POADocumentType {
-		return nil, fmt.Errorf("wrong document type: %s", document.Type)
-	}
+	log.Infof("searching entity by id %s", blockchainID)
 
-	return &document.POA, nil
+	return rep.get(blockchainID)
 }
 
 func (rep *POARepositoryImpl) Update(e *entity.POA) error {
@@ -84,6 +95,13 @@ func (rep *POARepositoryImpl) Update(e *entity.POA) error {
 	
 	log.Infof("updating entity with id %s", e.BlockchainID)
 
+	old, err := rep.get(e.BlockchainID)
+	if err == ErrPOANotFound {
+		old = nil
+	} else if err != nil {
+		return err
+	}
+
 	document := POADocument{
 			Document{
 				Type: POADocumentType,
@@ -101,6 +119,16 @@ func (rep *POARepositoryImpl) Update(e *entity.POA) error {
 		return err
 	}
 
+	err = rep.reindex(old, e)
+	if err != nil {
+		return err
+	}
+
+	err = setKeyEndorsement(rep.stub, document.BlockchainID, e.EndorsingMSPIDs())
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -108,13 +136,18 @@ func (rep *POARepositoryImpl) DeleteByBlockchainID(blockchainID string) error {
 	log := logs.WithTags(rep.log, "method", "DeleteByBlockchainID")
 	
 	log.Infof("deleting entity with id %s", blockchainID)
+
+	old, err := rep.get(blockchainID)
+	if err != nil {
+		return err
+	}
 	
-	err := rep.stub.DelState(blockchainID)
+	err = rep.stub.DelState(blockchainID)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return rep.reindex(old, nil)
 }
 
 func (rep *POARepositoryImpl) HistoryByBlockchainID(blockchainID string) ([]entity.POA, error) {
@@ -153,56 +186,101 @@ func (rep *POARepositoryImpl) HistoryByBlockchainID(blockchainID string) ([]enti
 
 }
 
-func (rep *POARepositoryImpl) List() ([]entity.POA, error) {
-	log := logs.WithTags(rep.log, "method", "List")
-	
-	log.Infof("getting all POA entities")
+// GetByBlockchainIDAt returns POA as it was committed at the moment according to the key history.
+func (rep *POARepositoryImpl) GetByBlockchainIDAt(blockchainID string, at time.Time) (*entity.POA, error) {
+	log := logs.WithTags(rep.log, "method", "GetByBlockchainIDAt")
 
-	query := fmt.Sprintf(`{"selector":{"type":"%s"}}`, POADocumentType)
+	log.Infof("searching entity by id %s at %s", blockchainID, at.Format(time.RFC3339))
 
-	iterator, err := rep.stub.GetQueryResult(query)
+	iterator, err := rep.stub.GetHistoryForKey(blockchainID)
 	if err != nil {
 		return nil, errors.New("failed to excute query: " + err.Error())
 	}
 
 	defer iterator.Close()
 
-	var entities []entity.POA
+	var (
+		found     bool
+		latest    time.Time
+		isDelete  bool
+		value     []byte
+	)
 
 	for iterator.HasNext() {
 		entry, err := iterator.Next()
 		if err != nil {
 			return nil, errors.New("failed to get next entry: " + err.Error())
 		}
-
-		var document POADocument
-		err = json.Unmarshal(entry.Value, &document)
-		if err != nil {
-			return nil, err
+		if entry.Timestamp == nil {
+			continue
 		}
-		if document.Type != POADocumentType {
-			return nil, fmt.Errorf("wrong document type: %s", document.Type)
+
+		ts := time.Unix(entry.Timestamp.Seconds, int64(entry.Timestamp.Nanos)).UTC()
+		if ts.After(at) || (found && ts.Before(latest)) {
+			continue
 		}
 
-		entities = append(entities, document.POA)
+		found, latest, isDelete, value = true, ts, entry.IsDelete, entry.Value
 	}
 
-	return entities, nil
+	if !found || isDelete {
+		return nil, ErrPOANotFound
+	}
+
+	var document POADocument
+	err = json.Unmarshal(value, &document)
+	if err != nil {
+		return nil, err
+	}
+	if document.Type != POADocumentType {
+		return nil, fmt.Errorf("wrong document type: %s", document.Type)
+	}
+
+	return &document.POA, nil
 }
 
-func (rep *POARepositoryImpl) FindItem(query string) (*entity.POA, error) {
-	log := logs.WithTags(rep.log, "method", "FindItem")
-	
-	log.Infof("finding entity item by query")
+// List returns page of all POAs, all of them when page size is not positive.
+func (rep *POARepositoryImpl) List(pageSize int32, bookmark string) (*entity.POAPage, error) {
+	log := logs.WithTags(rep.log, "method", "List")
 	
-	iterator, err := rep.stub.GetQueryResult(query)
+	log.Infof("getting POA entities page of %d after %q", pageSize, bookmark)
+
+	query, err := poaQuery(&entity.POASearchRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := rep.query(query, pageSize, bookmark)
+	if isRichQueryUnsupported(err) {
+		log.Infof("rich queries are not supported, using indexes")
+		return rep.findByIndex(&entity.POASearchRequest{PageSize: pageSize, Bookmark: bookmark})
+	}
+	return page, err
+}
+
+// query returns page of POAs matching CouchDB query, all of them when page size is not positive.
+func (rep *POARepositoryImpl) query(query *mango.Query, pageSize int32, bookmark string) (*entity.POAPage, error) {
+	queryJSON, err := query.JSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		iterator shim.StateQueryIteratorInterface
+		metadata *pb.QueryResponseMetadata
+	)
+	if pageSize > 0 {
+		iterator, metadata, err = rep.stub.GetQueryResultWithPagination(queryJSON, pageSize, bookmark)
+	} else {
+		iterator, err = rep.stub.GetQueryResult(queryJSON)
+	}
 	if err != nil {
 		return nil, errors.New("failed to excute query: " + err.Error())
 	}
 
 	defer iterator.Close()
 
-	var entity *entity.POA
+	page := new(entity.POAPage)
 
 	for iterator.HasNext() {
 		entry, err := iterator.Next()
@@ -219,79 +297,133 @@ func (rep *POARepositoryImpl) FindItem(query string) (*entity.POA, error) {
 			return nil, fmt.Errorf("wrong document type: %s", document.Type)
 		}
 
-		entity = &document.POA
+		page.POAs = append(page.POAs, document.POA)
 	}
 
-	if entity == nil {
-		return nil, ErrPOANotFound
+	if metadata != nil {
+		page.Bookmark = metadata.Bookmark
 	}
 
-	return entity, nil
+	return page, nil
 }
 
-func (rep *POARepositoryImpl) Find(req *entity.POASearchRequest) ([]entity.POA, error) {
+// FindItem returns the last POA matching CouchDB query, it is not available on peers without CouchDB.
+func (rep *POARepositoryImpl) FindItem(query *mango.Query) (*entity.POA, error) {
 	log := logs.WithTags(rep.log, "method", "FindItem")
 	
-	log.Infof("finding entity item by search request %+v", req)
-	
-	querySelector := map[string]interface{}{"type": POADocumentType}
-	
-	if req.State != nil{
-		querySelector["State"] = *req.State
-	}
-    if req.DateFrom != nil{
-		querySelector["DateFrom"] = *req.DateFrom
+	log.Infof("finding entity item by query")
+
+	page, err := rep.query(query, 0, "")
+	if err != nil {
+		return nil, err
 	}
-    if req.DateTo != nil{
-		querySelector["DateTo"] = *req.DateTo
+
+	if len(page.POAs) == 0 {
+		return nil, ErrPOANotFound
 	}
-    if req.AuthorityINN != nil{
-		querySelector["AuthorityINN"] = *req.AuthorityINN
+
+	return &page.POAs[len(page.POAs)-1], nil
+}
+
+// Find returns page of POAs matching the search request, all of them when page size is not positive.
+func (rep *POARepositoryImpl) Find(req *entity.POASearchRequest) (*entity.POAPage, error) {
+	log := logs.WithTags(rep.log, "method", "Find")
+	
+	log.Infof("finding entity item by search request %+v", req)
+
+	if isCompositeIndexSearch(req) {
+		return rep.findByIndex(req)
 	}
-    
 
-	query, err := json.Marshal(querySelector)
+	query, err := poaQuery(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to format query: %s", err)
+		return nil, err
 	}
 
-	iterator, err := rep.stub.GetQueryResult(string(query))
+	page, err := rep.query(query, req.PageSize, req.Bookmark)
+	if isRichQueryUnsupported(err) {
+		log.Infof("rich queries are not supported, using indexes")
+		return rep.findByIndex(req)
+	}
 	if err != nil {
-		return nil, errors.New("failed to excute query: " + err.Error())
+		return nil, err
 	}
 
-	defer iterator.Close()
+	// selectors compare dates as strings, the exact validity moment is checked here
+	matched := page.POAs[:0]
+	for inx := range page.POAs {
+		if req.Matches(&page.POAs[inx]) {
+			matched = append(matched, page.POAs[inx])
+		}
+	}
+	page.POAs = matched
 
-	var entities []entity.POA
+	return page, nil
+}
 
-	for iterator.HasNext() {
-		entry, err := iterator.Next()
+// poaQuery returns CouchDB query of the search request.
+// Every selector must be covered by an index in META-INF/statedb/couchdb/indexes,
+// except selectors of composite key index searches, which Find does not send to CouchDB.
+func poaQuery(req *entity.POASearchRequest) (*mango.Query, error) {
+	builder := mango.For(POADocument{}).Where(mango.Eq("Type", POADocumentType))
+	
+	if req.State != nil{
+		builder.Where(mango.Eq("State", *req.State))
+	}
+    if req.DateFrom != nil{
+		builder.Where(mango.Eq("DateFrom", *req.DateFrom))
+	}
+    if req.DateTo != nil{
+		builder.Where(mango.Eq("DateTo", *req.DateTo))
+	}
+    if req.AuthorityINN != nil{
+		builder.Where(mango.Eq("AuthorityINN", *req.AuthorityINN))
+	}
+    if req.PrincipalINN != nil{
+		builder.Where(mango.Eq("Principal.INN", *req.PrincipalINN))
+	}
+    if req.RepresentativeINN != nil{
+		builder.Where(mango.ElemMatch("Representatives", mango.Eq("INN", *req.RepresentativeINN)))
+	}
+    if req.ParentID != nil{
+		builder.Where(mango.Eq("ParentID", *req.ParentID))
+	}
+    if req.DateFromRange != nil{
+		conditions, err := dateRangeConditions("DateFrom", req.DateFromRange)
 		if err != nil {
-			return nil, errors.New("failed to get next entry: " + err.Error())
+			return nil, err
 		}
-
-		var document POADocument
-		err = json.Unmarshal(entry.Value, &document)
+		builder.Where(conditions...)
+	}
+    if req.DateToRange != nil{
+		conditions, err := dateRangeConditions("DateTo", req.DateToRange)
 		if err != nil {
 			return nil, err
 		}
-		if document.Type != POADocumentType {
-			return nil, fmt.Errorf("wrong document type: %s", document.Type)
+		builder.Where(conditions...)
+	}
+    if req.ValidAt != nil{
+		conditions, err := validAtConditions(*req.ValidAt)
+		if err != nil {
+			return nil, err
 		}
-
-		entities = append(entities, document.POA)
+		builder.Where(conditions...)
 	}
 
-	return entities, nil
+	return builder.Build()
 }
 
 func NewPOARepositoryImpl(
 	log logs.Logger,
 	stub shim.ChaincodeStubInterface,
+	clock txcontext.Clock,
+	seq *int,
 ) POARepository {
 	return &POARepositoryImpl{
 		log: log,
 		
 		stub: stub,
+		clock: clock,
+		seq: seq,
     	}
 }
@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/procsy-tech/attorney/entity"
+)
+
+// Composite key object types of POA secondary indexes, the blockchain id is the last key attribute.
+// The indexes answer search requests on peers without CouchDB.
+const (
+	POAByStateIndex             = "POA~state~id"
+	POAByAuthorityINNIndex      = "POA~authority_inn~id"
+	POAByPrincipalINNIndex      = "POA~principal_inn~id"
+	POAByRepresentativeINNIndex = "POA~representative_inn~id"
+)
+
+// indexValue is the value of index keys, the state database does not keep empty values.
+var indexValue = []byte{0x00}
+
+// indexKeys returns secondary index keys of POA.
+func (rep *POARepositoryImpl) indexKeys(e *entity.POA) ([]string, error) {
+	type attrs struct {
+		index string
+		value string
+	}
+	values := []attrs{
+		{POAByStateIndex, string(e.State)},
+		{POAByAuthorityINNIndex, e.AuthorityINN},
+	}
+	if e.Principal != nil {
+		values = append(values, attrs{POAByPrincipalINNIndex, e.Principal.INN})
+	}
+	for _, party := range e.Representatives {
+		values = append(values, attrs{POAByRepresentativeINNIndex, party.INN})
+	}
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, v := range values {
+		if len(v.value) == 0 {
+			continue
+		}
+		key, err := rep.stub.CreateCompositeKey(v.index, []string{v.value, e.BlockchainID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create index key: %s", err)
+		}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// reindex replaces index keys of the old POA version with keys of the new one, either may be nil.
+func (rep *POARepositoryImpl) reindex(old *entity.POA, e *entity.POA) error {
+	var oldKeys, newKeys []string
+	var err error
+	if old != nil {
+		oldKeys, err = rep.indexKeys(old)
+		if err != nil {
+			return err
+		}
+	}
+	if e != nil {
+		newKeys, err = rep.indexKeys(e)
+		if err != nil {
+			return err
+		}
+	}
+
+	kept := make(map[string]bool)
+	for _, key := range newKeys {
+		kept[key] = true
+	}
+	for _, key := range oldKeys {
+		if kept[key] {
+			continue
+		}
+		err = rep.stub.DelState(key)
+		if err != nil {
+			return err
+		}
+	}
+	for _, key := range newKeys {
+		err = rep.stub.PutState(key, indexValue)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// searchIndex picks the index answering the search request, the state index without attributes lists all POAs.
+func searchIndex(req *entity.POASearchRequest) (string, []string) {
+	switch {
+	case req.AuthorityINN != nil:
+		return POAByAuthorityINNIndex, []string{*req.AuthorityINN}
+	case req.PrincipalINN != nil:
+		return POAByPrincipalINNIndex, []string{*req.PrincipalINN}
+	case req.RepresentativeINN != nil:
+		return POAByRepresentativeINNIndex, []string{*req.RepresentativeINN}
+	case req.State != nil:
+		return POAByStateIndex, []string{string(*req.State)}
+	default:
+		return POAByStateIndex, []string{}
+	}
+}
+
+// isCompositeIndexSearch reports whether the search request is answered by composite key indexes on every peer.
+// CouchDB json indexes do not index array elements, so selectors by representative would scan all POAs.
+func isCompositeIndexSearch(req *entity.POASearchRequest) bool {
+	return req.RepresentativeINN != nil
+}
+
+// findByIndex answers the search request through composite key indexes,
+// criteria not covered by the index are checked on the loaded POAs,
+// so a page may hold fewer POAs than its size even if more POAs match.
+func (rep *POARepositoryImpl) findByIndex(req *entity.POASearchRequest) (*entity.POAPage, error) {
+	index, attrs := searchIndex(req)
+
+	var (
+		iterator shim.StateQueryIteratorInterface
+		metadata *pb.QueryResponseMetadata
+		err      error
+	)
+	if req.PageSize > 0 {
+		iterator, metadata, err = rep.stub.GetStateByPartialCompositeKeyWithPagination(index, attrs, req.PageSize, req.Bookmark)
+	} else {
+		iterator, err = rep.stub.GetStateByPartialCompositeKey(index, attrs)
+	}
+	if err != nil {
+		return nil, errors.New("failed to excute query: " + err.Error())
+	}
+
+	defer iterator.Close()
+
+	page := new(entity.POAPage)
+
+	for iterator.HasNext() {
+		entry, err := iterator.Next()
+		if err != nil {
+			return nil, errors.New("failed to get next entry: " + err.Error())
+		}
+
+		_, keyAttrs, err := rep.stub.SplitCompositeKey(entry.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to split index key: %s", err)
+		}
+		if len(keyAttrs) == 0 {
+			return nil, fmt.Errorf("wrong index key: %s", entry.Key)
+		}
+
+		e, err := rep.get(keyAttrs[len(keyAttrs)-1])
+		if err != nil {
+			return nil, err
+		}
+		if !req.Matches(e) {
+			continue
+		}
+
+		page.POAs = append(page.POAs, *e)
+	}
+
+	if metadata != nil {
+		page.Bookmark = metadata.Bookmark
+	}
+
+	return page, nil
+}
+
+// get loads POA document by blockchain id.
+func (rep *POARepositoryImpl) get(blockchainID string) (*entity.POA, error) {
+	data, err := rep.stub.GetState(blockchainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, ErrPOANotFound
+	}
+
+	document := new(POADocument)
+
+	err = json.Unmarshal(data, document)
+	if err != nil {
+		return nil, err
+	}
+
+	if document.Type != POADocumentType {
+		return nil, fmt.Errorf("wrong document type: %s", document.Type)
+	}
+
+	return &document.POA, nil
+}
+
+// isRichQueryUnsupported reports whether the query failed because the peer state database is LevelDB.
+func isRichQueryUnsupported(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "not supported for leveldb")
+}
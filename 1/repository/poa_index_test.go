@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+
+	"github.com/procsy-tech/attorney/entity"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// hasIndexKey reports whether the stub holds the index key of POA.
+func hasIndexKey(stub *testStub, index string, value string, id string) bool {
+	key, err := stub.CreateCompositeKey(index, []string{value, id})
+	So(err, ShouldBeNil)
+	data, err := stub.GetState(key)
+	So(err, ShouldBeNil)
+	return data != nil
+}
+
+// blockchainIDs returns ids of the page POAs.
+func blockchainIDs(page *entity.POAPage) []string {
+	var ids []string
+	for _, e := range page.POAs {
+		ids = append(ids, e.BlockchainID)
+	}
+	return ids
+}
+
+// couchDBStub is a peer with CouchDB state database whose rich queries must not be used.
+type couchDBStub struct {
+	*testStub
+}
+
+var errUnexpectedQuery = errors.New("unexpected rich query")
+
+func (s *couchDBStub) GetQueryResult(query string) (shim.StateQueryIteratorInterface, error) {
+	return nil, errUnexpectedQuery
+}
+
+func (s *couchDBStub) GetQueryResultWithPagination(query string, pageSize int32,
+	bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	return nil, nil, errUnexpectedQuery
+}
+
+func TestPOAIndexes(t *testing.T) {
+	Convey("Given POA repository", t, func(c C) {
+		stub := newTestStub()
+		rep := newTestPOARepository(stub)
+
+		c.Convey("When POA is created", func(c C) {
+			e := testPOA()
+			id, err := rep.New(e)
+			So(err, ShouldBeNil)
+
+			c.Convey("It should write its index keys", func(c C) {
+				So(hasIndexKey(stub, POAByStateIndex, entity.POAStateCreated, id), ShouldBeTrue)
+				So(hasIndexKey(stub, POAByAuthorityINNIndex, "7707083893", id), ShouldBeTrue)
+				So(hasIndexKey(stub, POAByPrincipalINNIndex, "7707083893", id), ShouldBeTrue)
+				So(hasIndexKey(stub, POAByRepresentativeINNIndex, "500100732259", id), ShouldBeTrue)
+			})
+			c.Convey("It should replace stale keys when state and representatives change", func(c C) {
+				e.State = entity.POAStateSent
+				e.Representatives = []entity.Party{{Type: entity.PartyTypeIndividual, INN: "771234567859"}}
+				So(rep.Update(e), ShouldBeNil)
+
+				So(hasIndexKey(stub, POAByStateIndex, entity.POAStateCreated, id), ShouldBeFalse)
+				So(hasIndexKey(stub, POAByStateIndex, entity.POAStateSent, id), ShouldBeTrue)
+				So(hasIndexKey(stub, POAByRepresentativeINNIndex, "500100732259", id), ShouldBeFalse)
+				So(hasIndexKey(stub, POAByRepresentativeINNIndex, "771234567859", id), ShouldBeTrue)
+				So(hasIndexKey(stub, POAByPrincipalINNIndex, "7707083893", id), ShouldBeTrue)
+			})
+			c.Convey("It should remove its index keys on delete", func(c C) {
+				So(rep.DeleteByBlockchainID(id), ShouldBeNil)
+
+				So(hasIndexKey(stub, POAByStateIndex, entity.POAStateCreated, id), ShouldBeFalse)
+				So(hasIndexKey(stub, POAByAuthorityINNIndex, "7707083893", id), ShouldBeFalse)
+				So(hasIndexKey(stub, POAByPrincipalINNIndex, "7707083893", id), ShouldBeFalse)
+				So(hasIndexKey(stub, POAByRepresentativeINNIndex, "500100732259", id), ShouldBeFalse)
+			})
+		})
+
+		c.Convey("When POAs of several principals are created on peer without CouchDB", func(c C) {
+			first, err := rep.New(testPOA())
+			So(err, ShouldBeNil)
+			other := testPOA()
+			other.AuthorityINN = "500100732259"
+			other.Principal = &entity.Party{Type: entity.PartyTypeIndividual, INN: "500100732259"}
+			second, err := rep.New(other)
+			So(err, ShouldBeNil)
+			sent := testPOA()
+			sent.State = entity.POAStateSent
+			third, err := rep.New(sent)
+			So(err, ShouldBeNil)
+
+			c.Convey("It should find POAs of the principal through indexes", func(c C) {
+				inn := "7707083893"
+				page, err := rep.Find(&entity.POASearchRequest{PrincipalINN: &inn})
+				So(err, ShouldBeNil)
+				So(blockchainIDs(page), ShouldHaveLength, 2)
+				So(blockchainIDs(page), ShouldContain, first)
+				So(blockchainIDs(page), ShouldContain, third)
+			})
+			c.Convey("It should check criteria not covered by the index", func(c C) {
+				inn := "7707083893"
+				state := entity.POAState(entity.POAStateSent)
+				page, err := rep.Find(&entity.POASearchRequest{PrincipalINN: &inn, State: &state})
+				So(err, ShouldBeNil)
+				So(blockchainIDs(page), ShouldResemble, []string{third})
+			})
+			c.Convey("It should find POAs of the representative through index on peer with CouchDB", func(c C) {
+				rep := newTestPOARepository(&couchDBStub{stub})
+				inn := "500100732259"
+				page, err := rep.Find(&entity.POASearchRequest{RepresentativeINN: &inn, PageSize: 2})
+				So(err, ShouldBeNil)
+				So(blockchainIDs(page), ShouldHaveLength, 2)
+				So(page.Bookmark, ShouldNotBeEmpty)
+
+				page, err = rep.Find(&entity.POASearchRequest{RepresentativeINN: &inn, PageSize: 2, Bookmark: page.Bookmark})
+				So(err, ShouldBeNil)
+				So(blockchainIDs(page), ShouldHaveLength, 1)
+			})
+			c.Convey("It should list all POAs through the state index", func(c C) {
+				page, err := rep.List(0, "")
+				So(err, ShouldBeNil)
+				So(blockchainIDs(page), ShouldHaveLength, 3)
+				So(blockchainIDs(page), ShouldContain, second)
+			})
+		})
+	})
+}
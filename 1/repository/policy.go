@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/utils/logs"
+)
+
+var (
+	ErrPolicyNotFound = errors.New("policy table not found")
+)
+
+type (
+	// PolicyRepository keeps the single route authorization policy table.
+	PolicyRepository interface {
+		Get() (*entity.PolicyTable, error)
+		Put(*entity.PolicyTable) error
+	}
+
+	PolicyRepositoryImpl struct {
+		log  logs.Logger
+		stub shim.ChaincodeStubInterface
+	}
+)
+
+// PolicyDocument is the route authorization policy table stored in the ledger.
+type PolicyDocument struct {
+	Document
+	entity.PolicyTable
+}
+
+func (rep *PolicyRepositoryImpl) key() (string, error) {
+	return rep.stub.CreateCompositeKey(PolicyDocumentType, []string{})
+}
+
+func (rep *PolicyRepositoryImpl) Get() (*entity.PolicyTable, error) {
+	log := logs.WithTags(rep.log, "method", "Get")
+
+	log.Debugf("getting policy table")
+
+	key, err := rep.key()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := rep.stub.GetState(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, ErrPolicyNotFound
+	}
+
+	document := new(PolicyDocument)
+
+	err = json.Unmarshal(data, document)
+	if err != nil {
+		return nil, err
+	}
+
+	if document.Type != PolicyDocumentType {
+		return nil, fmt.Errorf("wrong document type: %s", document.Type)
+	}
+
+	return &document.PolicyTable, nil
+}
+
+func (rep *PolicyRepositoryImpl) Put(e *entity.PolicyTable) error {
+	log := logs.WithTags(rep.log, "method", "Put")
+
+	log.Infof("saving policy table of %d routes", len(e.Routes))
+
+	key, err := rep.key()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(PolicyDocument{
+		Document{
+			Type: PolicyDocumentType,
+		},
+		*e,
+	})
+	if err != nil {
+		return err
+	}
+
+	return rep.stub.PutState(key, data)
+}
+
+func NewPolicyRepositoryImpl(
+	log logs.Logger,
+	stub shim.ChaincodeStubInterface,
+) PolicyRepository {
+	return &PolicyRepositoryImpl{
+		log:  log,
+		stub: stub,
+	}
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/utils/logs"
+)
+
+var (
+	ErrPowerNotFound = errors.New("power not found")
+	ErrPowerExists   = errors.New("power already exists")
+)
+
+type (
+	PowerRepository interface {
+		New(*entity.PowerDefinition) error
+		GetByCode(string) (*entity.PowerDefinition, error)
+		Update(*entity.PowerDefinition) error
+		DeleteByCode(string) error
+		List() ([]entity.PowerDefinition, error)
+	}
+
+	PowerRepositoryImpl struct {
+		log  logs.Logger
+		stub shim.ChaincodeStubInterface
+	}
+)
+
+// PowerDocument is a powers catalog entry stored in the ledger.
+type PowerDocument struct {
+	Document
+	entity.PowerDefinition
+}
+
+func (rep *PowerRepositoryImpl) key(code string) (string, error) {
+	return rep.stub.CreateCompositeKey(PowerDocumentType, []string{code})
+}
+
+func (rep *PowerRepositoryImpl) put(e *entity.PowerDefinition) error {
+	key, err := rep.key(e.Code)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(PowerDocument{
+		Document{
+			Type: PowerDocumentType,
+		},
+		*e,
+	})
+	if err != nil {
+		return err
+	}
+
+	return rep.stub.PutState(key, data)
+}
+
+func (rep *PowerRepositoryImpl) New(e *entity.PowerDefinition) error {
+	log := logs.WithTags(rep.log, "method", "New")
+
+	log.Infof("creating power %s", e.Code)
+
+	_, err := rep.GetByCode(e.Code)
+	if err == nil {
+		return ErrPowerExists
+	}
+	if err != ErrPowerNotFound {
+		return err
+	}
+
+	return rep.put(e)
+}
+
+func (rep *PowerRepositoryImpl) GetByCode(code string) (*entity.PowerDefinition, error) {
+	log := logs.WithTags(rep.log, "method", "GetByCode")
+
+	log.Infof("searching power by code %s", code)
+
+	key, err := rep.key(code)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := rep.stub.GetState(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, ErrPowerNotFound
+	}
+
+	document := new(PowerDocument)
+
+	err = json.Unmarshal(data, document)
+	if err != nil {
+		return nil, err
+	}
+
+	if document.Type != PowerDocumentType {
+		return nil, fmt.Errorf("wrong document type: %s", document.Type)
+	}
+
+	return &document.PowerDefinition, nil
+}
+
+func (rep *PowerRepositoryImpl) Update(e *entity.PowerDefinition) error {
+	log := logs.WithTags(rep.log, "method", "Update")
+
+	log.Infof("updating power %s", e.Code)
+
+	_, err := rep.GetByCode(e.Code)
+	if err != nil {
+		return err
+	}
+
+	return rep.put(e)
+}
+
+func (rep *PowerRepositoryImpl) DeleteByCode(code string) error {
+	log := logs.WithTags(rep.log, "method", "DeleteByCode")
+
+	log.Infof("deleting power %s", code)
+
+	_, err := rep.GetByCode(code)
+	if err != nil {
+		return err
+	}
+
+	key, err := rep.key(code)
+	if err != nil {
+		return err
+	}
+
+	return rep.stub.DelState(key)
+}
+
+func (rep *PowerRepositoryImpl) List() ([]entity.PowerDefinition, error) {
+	log := logs.WithTags(rep.log, "method", "List")
+
+	log.Infof("getting powers catalog")
+
+	iterator, err := rep.stub.GetStateByPartialCompositeKey(PowerDocumentType, []string{})
+	if err != nil {
+		return nil, errors.New("failed to excute query: " + err.Error())
+	}
+
+	defer iterator.Close()
+
+	var entities []entity.PowerDefinition
+
+	for iterator.HasNext() {
+		entry, err := iterator.Next()
+		if err != nil {
+			return nil, errors.New("failed to get next entry: " + err.Error())
+		}
+
+		var document PowerDocument
+		err = json.Unmarshal(entry.Value, &document)
+		if err != nil {
+			return nil, err
+		}
+		if document.Type != PowerDocumentType {
+			return nil, fmt.Errorf("wrong document type: %s", document.Type)
+		}
+
+		entities = append(entities, document.PowerDefinition)
+	}
+
+	return entities, nil
+}
+
+func NewPowerRepositoryImpl(
+	log logs.Logger,
+	stub shim.ChaincodeStubInterface,
+) PowerRepository {
+	return &PowerRepositoryImpl{
+		log:  log,
+		stub: stub,
+	}
+}
@@ -1,6 +1,7 @@
 package repository
 
 import(
+	"github.com/procsy-tech/attorney/txcontext"
 	"github.com/procsy-tech/attorney/utils/logs"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 )
@@ -8,23 +9,46 @@ import(
 type (
 	Repository interface{
 		POARepository() POARepository
+		PowerRepository() PowerRepository
+		PolicyRepository() PolicyRepository
+		TrustAnchorRepository() TrustAnchorRepository
+		SettingsRepository() SettingsRepository
 		}
 
 	repositoryImpl struct {
 		log logs.Logger
 		stub shim.ChaincodeStubInterface
+		clock txcontext.Clock
+		// seq counts documents created in the transaction, it is shared by repositories of the transaction.
+		seq *int
 	}
 )
 
 func (rep *repositoryImpl)POARepository() POARepository{
-	return NewPOARepositoryImpl(logs.WithTags(rep.log, "entity", "POA"), rep.stub)
+	return NewPOARepositoryImpl(logs.WithTags(rep.log, "entity", "POA"), rep.stub, rep.clock, rep.seq)
+}
+func (rep *repositoryImpl)PowerRepository() PowerRepository{
+	return NewPowerRepositoryImpl(logs.WithTags(rep.log, "entity", "Power"), rep.stub)
+}
+func (rep *repositoryImpl)PolicyRepository() PolicyRepository{
+	return NewPolicyRepositoryImpl(logs.WithTags(rep.log, "entity", "Policy"), rep.stub)
+}
+func (rep *repositoryImpl)TrustAnchorRepository() TrustAnchorRepository{
+	return NewTrustAnchorRepositoryImpl(logs.WithTags(rep.log, "entity", "TrustAnchor"), rep.stub)
+}
+func (rep *repositoryImpl)SettingsRepository() SettingsRepository{
+	return NewSettingsRepositoryImpl(logs.WithTags(rep.log, "entity", "Settings"), rep.stub)
 }
 func NewRepositoryImpl(
 	log logs.Logger,
 	stub shim.ChaincodeStubInterface,
+	clock txcontext.Clock,
+	seq *int,
 ) Repository {
 	return &repositoryImpl{
 		log: log,
 		stub: stub,
+		clock: clock,
+		seq: seq,
 	}
 }
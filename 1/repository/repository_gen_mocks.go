@@ -46,3 +46,59 @@ func (mr *MockRepositoryMockRecorder) POARepository() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "POARepository", reflect.TypeOf((*MockRepository)(nil).POARepository))
 }
+
+// PolicyRepository mocks base method.
+func (m *MockRepository) PolicyRepository() PolicyRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PolicyRepository")
+	ret0, _ := ret[0].(PolicyRepository)
+	return ret0
+}
+
+// PolicyRepository indicates an expected call of PolicyRepository.
+func (mr *MockRepositoryMockRecorder) PolicyRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PolicyRepository", reflect.TypeOf((*MockRepository)(nil).PolicyRepository))
+}
+
+// PowerRepository mocks base method.
+func (m *MockRepository) PowerRepository() PowerRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerRepository")
+	ret0, _ := ret[0].(PowerRepository)
+	return ret0
+}
+
+// PowerRepository indicates an expected call of PowerRepository.
+func (mr *MockRepositoryMockRecorder) PowerRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerRepository", reflect.TypeOf((*MockRepository)(nil).PowerRepository))
+}
+
+// SettingsRepository mocks base method.
+func (m *MockRepository) SettingsRepository() SettingsRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettingsRepository")
+	ret0, _ := ret[0].(SettingsRepository)
+	return ret0
+}
+
+// SettingsRepository indicates an expected call of SettingsRepository.
+func (mr *MockRepositoryMockRecorder) SettingsRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettingsRepository", reflect.TypeOf((*MockRepository)(nil).SettingsRepository))
+}
+
+// TrustAnchorRepository mocks base method.
+func (m *MockRepository) TrustAnchorRepository() TrustAnchorRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrustAnchorRepository")
+	ret0, _ := ret[0].(TrustAnchorRepository)
+	return ret0
+}
+
+// TrustAnchorRepository indicates an expected call of TrustAnchorRepository.
+func (mr *MockRepositoryMockRecorder) TrustAnchorRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrustAnchorRepository", reflect.TypeOf((*MockRepository)(nil).TrustAnchorRepository))
+}
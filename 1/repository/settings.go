@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/utils/logs"
+)
+
+var (
+	ErrSettingsNotFound = errors.New("settings not found")
+)
+
+type (
+	// SettingsRepository keeps the single chaincode settings document.
+	SettingsRepository interface {
+		Get() (*entity.Settings, error)
+		Put(*entity.Settings) error
+	}
+
+	SettingsRepositoryImpl struct {
+		log  logs.Logger
+		stub shim.ChaincodeStubInterface
+	}
+)
+
+// SettingsDocument is the chaincode settings stored in the ledger.
+type SettingsDocument struct {
+	Document
+	entity.Settings
+}
+
+func (rep *SettingsRepositoryImpl) key() (string, error) {
+	return rep.stub.CreateCompositeKey(SettingsDocumentType, []string{})
+}
+
+func (rep *SettingsRepositoryImpl) Get() (*entity.Settings, error) {
+	log := logs.WithTags(rep.log, "method", "Get")
+
+	log.Debugf("getting settings")
+
+	key, err := rep.key()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := rep.stub.GetState(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, ErrSettingsNotFound
+	}
+
+	document := new(SettingsDocument)
+
+	err = json.Unmarshal(data, document)
+	if err != nil {
+		return nil, err
+	}
+
+	if document.Type != SettingsDocumentType {
+		return nil, fmt.Errorf("wrong document type: %s", document.Type)
+	}
+
+	return &document.Settings, nil
+}
+
+func (rep *SettingsRepositoryImpl) Put(e *entity.Settings) error {
+	log := logs.WithTags(rep.log, "method", "Put")
+
+	log.Infof("saving settings %+v", *e)
+
+	key, err := rep.key()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(SettingsDocument{
+		Document{
+			Type: SettingsDocumentType,
+		},
+		*e,
+	})
+	if err != nil {
+		return err
+	}
+
+	return rep.stub.PutState(key, data)
+}
+
+func NewSettingsRepositoryImpl(
+	log logs.Logger,
+	stub shim.ChaincodeStubInterface,
+) SettingsRepository {
+	return &SettingsRepositoryImpl{
+		log:  log,
+		stub: stub,
+	}
+}
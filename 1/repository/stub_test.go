@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/kbkontrakt/hlfabric-ccdevkit/logs"
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/txcontext"
+)
+
+// txClock returns transaction time of all test calls.
+var txClock = txcontext.ClockFunc(func() (time.Time, error) {
+	return time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC), nil
+})
+
+// testStub is shim.MockStub of a peer with LevelDB state database
+// completed with methods the mock does not implement.
+type testStub struct {
+	*shim.MockStub
+}
+
+// newTestStub returns stub inside transaction tx1.
+func newTestStub() *testStub {
+	stub := &testStub{shim.NewMockStub("attorney", nil)}
+	stub.MockTransactionStart("tx1")
+	return stub
+}
+
+func (s *testStub) DelPrivateData(collection string, key string) error {
+	delete(s.PvtState[collection], key)
+	return nil
+}
+
+// errLevelDBQuery is the error of rich queries on peers with LevelDB.
+var errLevelDBQuery = errors.New("ExecuteQuery not supported for leveldb")
+
+func (s *testStub) GetQueryResult(query string) (shim.StateQueryIteratorInterface, error) {
+	return nil, errLevelDBQuery
+}
+
+func (s *testStub) GetQueryResultWithPagination(query string, pageSize int32,
+	bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	return nil, nil, errLevelDBQuery
+}
+
+func (s *testStub) GetStateByPartialCompositeKeyWithPagination(objectType string, keys []string,
+	pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	iterator, err := s.GetStateByPartialCompositeKey(objectType, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return paginate(iterator, pageSize, bookmark)
+}
+
+// newTestPOARepository returns POA repository on the stub counting documents from zero.
+func newTestPOARepository(stub shim.ChaincodeStubInterface) POARepository {
+	var seq int
+	return NewPOARepositoryImpl(logs.DummyLogger(), stub, txClock, &seq)
+}
+
+// testPOA returns POA of Org1MSP principal for Org2MSP representative.
+func testPOA() *entity.POA {
+	return &entity.POA{
+		State:        entity.POAStateCreated,
+		DateFrom:     "2021-01-01",
+		DateTo:       "2021-12-31",
+		AuthorityINN: "7707083893",
+		Principal:    &entity.Party{Type: entity.PartyTypeLegalEntity, INN: "7707083893"},
+		Representatives: []entity.Party{
+			{Type: entity.PartyTypeIndividual, INN: "500100732259"},
+		},
+		PrincipalMSPID:      "Org1MSP",
+		RepresentativeMSPID: "Org2MSP",
+	}
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/utils/logs"
+)
+
+var (
+	ErrTrustAnchorNotFound = errors.New("trust anchor not found")
+	ErrTrustAnchorExists   = errors.New("trust anchor already exists")
+)
+
+type (
+	TrustAnchorRepository interface {
+		New(*entity.TrustAnchor) error
+		GetByFingerprint(string) (*entity.TrustAnchor, error)
+		DeleteByFingerprint(string) error
+		List() ([]entity.TrustAnchor, error)
+	}
+
+	TrustAnchorRepositoryImpl struct {
+		log  logs.Logger
+		stub shim.ChaincodeStubInterface
+	}
+)
+
+// TrustAnchorDocument is a trust anchor stored in the ledger.
+type TrustAnchorDocument struct {
+	Document
+	entity.TrustAnchor
+}
+
+func (rep *TrustAnchorRepositoryImpl) key(fingerprint string) (string, error) {
+	return rep.stub.CreateCompositeKey(TrustAnchorDocumentType, []string{fingerprint})
+}
+
+func (rep *TrustAnchorRepositoryImpl) put(e *entity.TrustAnchor) error {
+	key, err := rep.key(e.Fingerprint)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(TrustAnchorDocument{
+		Document{
+			Type: TrustAnchorDocumentType,
+		},
+		*e,
+	})
+	if err != nil {
+		return err
+	}
+
+	return rep.stub.PutState(key, data)
+}
+
+func (rep *TrustAnchorRepositoryImpl) New(e *entity.TrustAnchor) error {
+	log := logs.WithTags(rep.log, "method", "New")
+
+	log.Infof("adding trust anchor %s", e.Fingerprint)
+
+	_, err := rep.GetByFingerprint(e.Fingerprint)
+	if err == nil {
+		return ErrTrustAnchorExists
+	}
+	if err != ErrTrustAnchorNotFound {
+		return err
+	}
+
+	return rep.put(e)
+}
+
+func (rep *TrustAnchorRepositoryImpl) GetByFingerprint(fingerprint string) (*entity.TrustAnchor, error) {
+	log := logs.WithTags(rep.log, "method", "GetByFingerprint")
+
+	log.Infof("searching trust anchor by fingerprint %s", fingerprint)
+
+	key, err := rep.key(fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := rep.stub.GetState(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, ErrTrustAnchorNotFound
+	}
+
+	document := new(TrustAnchorDocument)
+
+	err = json.Unmarshal(data, document)
+	if err != nil {
+		return nil, err
+	}
+
+	if document.Type != TrustAnchorDocumentType {
+		return nil, fmt.Errorf("wrong document type: %s", document.Type)
+	}
+
+	return &document.TrustAnchor, nil
+}
+
+func (rep *TrustAnchorRepositoryImpl) DeleteByFingerprint(fingerprint string) error {
+	log := logs.WithTags(rep.log, "method", "DeleteByFingerprint")
+
+	log.Infof("deleting trust anchor %s", fingerprint)
+
+	_, err := rep.GetByFingerprint(fingerprint)
+	if err != nil {
+		return err
+	}
+
+	key, err := rep.key(fingerprint)
+	if err != nil {
+		return err
+	}
+
+	return rep.stub.DelState(key)
+}
+
+func (rep *TrustAnchorRepositoryImpl) List() ([]entity.TrustAnchor, error) {
+	log := logs.WithTags(rep.log, "method", "List")
+
+	log.Infof("getting trust anchors")
+
+	iterator, err := rep.stub.GetStateByPartialCompositeKey(TrustAnchorDocumentType, []string{})
+	if err != nil {
+		return nil, errors.New("failed to excute query: " + err.Error())
+	}
+
+	defer iterator.Close()
+
+	var entities []entity.TrustAnchor
+
+	for iterator.HasNext() {
+		entry, err := iterator.Next()
+		if err != nil {
+			return nil, errors.New("failed to get next entry: " + err.Error())
+		}
+
+		var document TrustAnchorDocument
+		err = json.Unmarshal(entry.Value, &document)
+		if err != nil {
+			return nil, err
+		}
+		if document.Type != TrustAnchorDocumentType {
+			return nil, fmt.Errorf("wrong document type: %s", document.Type)
+		}
+
+		entities = append(entities, document.TrustAnchor)
+	}
+
+	return entities, nil
+}
+
+func NewTrustAnchorRepositoryImpl(
+	log logs.Logger,
+	stub shim.ChaincodeStubInterface,
+) TrustAnchorRepository {
+	return &TrustAnchorRepositoryImpl{
+		log:  log,
+		stub: stub,
+	}
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/txcontext"
+)
+
+const (
+	// AttrOrganizationINN is the certificate attribute holding INN of the party the client acts for.
+	AttrOrganizationINN = "attorney.inn"
+	// AttrRole is the certificate attribute holding the client role.
+	AttrRole = "attorney.role"
+
+	// RoleConfirmer may confirm or reject POAs. It is granted by AttrRole or by the certificate OU.
+	RoleConfirmer = "confirmer"
+	// RoleAdmin may use the _debug route once it is enabled. It is granted by AttrRole or by the certificate OU.
+	RoleAdmin = "admin"
+)
+
+// AccessDeniedError is returned when the client identity is not allowed to perform the operation.
+type AccessDeniedError struct {
+	Operation string
+	Reason    string
+}
+
+func (e *AccessDeniedError) Error() string {
+	return fmt.Sprintf("access denied to %s: %s", e.Operation, e.Reason)
+}
+
+// IsAccessDenied reports whether err is an AccessDeniedError.
+func IsAccessDenied(err error) bool {
+	_, ok := err.(*AccessDeniedError)
+	return ok
+}
+
+// authorizePrincipal allows the operation to clients of the principal's organization only:
+// the client certificate must carry the principal INN in AttrOrganizationINN and, once the POA
+// is registered, the client must belong to the MSP which registered it.
+func (svc *POAServiceImpl) authorizePrincipal(operation string, POA *entity.POA) error {
+	if POA.Principal == nil {
+		return &AccessDeniedError{Operation: operation, Reason: "POA has no principal"}
+	}
+
+	inn, found, err := svc.identity.Attribute(AttrOrganizationINN)
+	if err != nil {
+		return fmt.Errorf("failed to get client attribute %s: %s", AttrOrganizationINN, err)
+	}
+	if !found || inn != POA.Principal.INN {
+		return &AccessDeniedError{Operation: operation, Reason: "client does not act for the principal"}
+	}
+
+	if len(POA.PrincipalMSPID) != 0 {
+		mspID, err := svc.identity.MSPID()
+		if err != nil {
+			return fmt.Errorf("failed to get client MSP ID: %s", err)
+		}
+		if mspID != POA.PrincipalMSPID {
+			return &AccessDeniedError{Operation: operation, Reason: "client does not belong to the principal's organization"}
+		}
+	}
+
+	return nil
+}
+
+// authorizeRole allows the operation to clients having the role.
+func (svc *POAServiceImpl) authorizeRole(operation string, role string) error {
+	ok, err := hasRole(svc.identity, role)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &AccessDeniedError{Operation: operation, Reason: fmt.Sprintf("client has no %s role", role)}
+	}
+	return nil
+}
+
+// hasRole reports whether the client has the role in AttrRole or in the certificate OU.
+func hasRole(identity txcontext.Identity, role string) (bool, error) {
+	value, found, err := identity.Attribute(AttrRole)
+	if err != nil {
+		return false, fmt.Errorf("failed to get client attribute %s: %s", AttrRole, err)
+	}
+	if found && value == role {
+		return true, nil
+	}
+
+	hasOU, err := identity.HasOU(role)
+	if err != nil {
+		return false, fmt.Errorf("failed to get client OU: %s", err)
+	}
+	return hasOU, nil
+}
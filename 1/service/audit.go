@@ -0,0 +1,38 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/signature"
+)
+
+// audit appends the record of the transition made by the client to the POA audit trail.
+func (svc *POAServiceImpl) audit(POA *entity.POA, transition string, from entity.POAState, comment string) error {
+	mspID, err := svc.identity.MSPID()
+	if err != nil {
+		return fmt.Errorf("failed to get client MSP ID: %s", err)
+	}
+	cert, err := svc.identity.Certificate()
+	if err != nil {
+		return fmt.Errorf("failed to get client certificate: %s", err)
+	}
+	now, err := svc.clock.Now()
+	if err != nil {
+		return err
+	}
+
+	POA.Audit = append(POA.Audit, entity.AuditRecord{
+		Transition:  transition,
+		From:        from,
+		To:          POA.State,
+		MSPID:       mspID,
+		Subject:     cert.Subject.String(),
+		Fingerprint: signature.Fingerprint(cert),
+		TxID:        svc.tx.ID(),
+		Timestamp:   now.Format(time.RFC3339),
+		Comment:     comment,
+	})
+	return nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/utils/logs"
+)
+
+// validateConfirmationPolicy checks that the policy requires at least one approval
+// of distinct eligible organizations and can be met.
+func (svc *POAServiceImpl) validateConfirmationPolicy(policy *entity.ConfirmationPolicy) error {
+	if len(policy.MSPIDs) == 0 {
+		return &ValidationError{Field: "confirmation_policy.msp_ids", Reason: "required"}
+	}
+	for inx, mspID := range policy.MSPIDs {
+		if len(mspID) == 0 {
+			return &ValidationError{Field: fmt.Sprintf("confirmation_policy.msp_ids[%d]", inx), Reason: "required"}
+		}
+		if contains(policy.MSPIDs[:inx], mspID) {
+			return &ValidationError{Field: fmt.Sprintf("confirmation_policy.msp_ids[%d]", inx), Reason: "duplicate"}
+		}
+	}
+	if policy.Required < 1 || policy.Required > len(policy.MSPIDs) {
+		return &ValidationError{
+			Field:  "confirmation_policy.required",
+			Reason: fmt.Sprintf("must be between 1 and %d", len(policy.MSPIDs)),
+		}
+	}
+	return nil
+}
+
+// approve records the approval of the client's organization and confirms POA once its confirmation
+// policy is met. Repeated approval of the same organization changes nothing and returns errNoChange.
+func (svc *POAServiceImpl) approve(POA *entity.POA) error {
+	log := logs.WithTags(svc.log, "method", "ConfirmAttorney")
+
+	mspID, err := svc.identity.MSPID()
+	if err != nil {
+		return fmt.Errorf("failed to get client MSP ID: %s", err)
+	}
+	if !POA.ConfirmationPolicy.IsEligible(mspID) {
+		return &AccessDeniedError{
+			Operation: "ConfirmAttorney",
+			Reason:    fmt.Sprintf("organization %s is not eligible to approve POA", mspID),
+		}
+	}
+	if POA.IsApprovedBy(mspID) {
+		return errNoChange
+	}
+	if POA.State != entity.POAStateSent {
+		return fmt.Errorf("POA in state %s can not be approved", POA.State)
+	}
+
+	approverID, err := svc.identity.ID()
+	if err != nil {
+		return fmt.Errorf("failed to get approver identity: %s", err)
+	}
+	now, err := svc.clock.Now()
+	if err != nil {
+		return err
+	}
+	POA.Approvals = append(POA.Approvals, entity.Approval{
+		MSPID:      mspID,
+		ApproverID: approverID,
+		Timestamp:  now.Format(time.RFC3339),
+	})
+	log.Infof("POA %s approved by %s (%d of %d)",
+		POA.BlockchainID, mspID, len(POA.Approvals), POA.ConfirmationPolicy.Required)
+
+	if !POA.HasEnoughApprovals() {
+		return nil
+	}
+	return POA.SetStateConfirmed()
+}
@@ -9,9 +9,41 @@ import (
 
 // POAService interface.
 type POAService interface {
-	Create(POA *entity.POA) (int64, error)
-	ConfirmAttorney(ID string) error
+	Create(POA *entity.POA) (string, error)
+	ConfirmAttorney(ID string, Comment string) error
+	SendAttorney(ID string, Comment string) error
+	ReturnAttorney(ID string, Comment string) error
+	RejectAttorney(ID string, Comment string) error
+	RevokeAttorney(ID string, Reason string) error
+	DelegationChain(ID string) ([]entity.POA, error)
+	VerifyAuthority(RepresentativeINN string, PrincipalINN string, PowerCode string, Timestamp string) (*entity.AuthorityVerdict, error)
+	GetApprovals(ID string) ([]entity.Approval, error)
+	GetAuditTrail(ID string) ([]entity.AuditRecord, error)
+	FindAttorneys(Request *entity.POASearchRequest) (*entity.POAPage, error)
 	
 }
 
+// PowerService interface.
+type PowerService interface {
+	CreatePower(Power *entity.PowerDefinition) error
+	UpdatePower(Power *entity.PowerDefinition) error
+	DeletePower(Code string) error
+	GetPower(Code string) (*entity.PowerDefinition, error)
+	ListPowers() ([]entity.PowerDefinition, error)
+}
+
+// PolicyService interface.
+type PolicyService interface {
+	Authorize(Route string) error
+	GetPolicy() (*entity.PolicyTable, error)
+	UpdatePolicy(Policy *entity.PolicyTable) error
+	EnableDebug(Enabled bool) error
+	AuthorizeDebug() error
+}
 
+// TrustAnchorService interface.
+type TrustAnchorService interface {
+	AddTrustAnchor(Anchor *entity.TrustAnchor) error
+	DeleteTrustAnchor(Fingerprint string) error
+	ListTrustAnchors() ([]entity.TrustAnchor, error)
+}
@@ -2,36 +2,731 @@ package service
 
 import (
 	"errors"
-	
+	"fmt"
+	"strings"
+	"time"
+
 		"github.com/procsy-tech/attorney/entity"
-	
+
 	"github.com/procsy-tech/attorney/repository"
+	"github.com/procsy-tech/attorney/txcontext"
 	"github.com/procsy-tech/attorney/utils/logs"
+	"github.com/procsy-tech/attorney/validation"
+)
+
+var (
+	ErrEmptyPOA               = errors.New("POA is empty")
+	ErrPOAExpired             = errors.New("POA is expired")
+	ErrSubstitutionNotAllowed = errors.New("parent POA does not allow substitution")
+	ErrParentPOANotInForce    = errors.New("parent POA is not in force")
+	ErrDelegationChainTooDeep = errors.New("delegation chain is too deep or cyclic")
 )
 
+// errNoChange is returned by state transitions leaving POA as it is, transit saves nothing then.
+var errNoChange = errors.New("no change")
+
+// maxDelegationDepth limits the number of ancestors walked through ParentID links.
+const maxDelegationDepth = 16
+
+// ValidationError describes a POA field that failed validation.
+type ValidationError struct {
+	Field  string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid field %s: %s", e.Field, e.Reason)
+}
+
 func NewPOAServiceImpl(
 	log logs.Logger,
 	rep repository.Repository,
+	clock txcontext.Clock,
+	identity txcontext.Identity,
+	tx txcontext.Transaction,
 ) POAService {
 	return &POAServiceImpl{
 		log,
 		rep,
+		clock,
+		identity,
+		tx,
 	}
 }
 
 type POAServiceImpl struct {
-	log logs.Logger
-	rep repository.Repository
+	log      logs.Logger
+	rep      repository.Repository
+	clock    txcontext.Clock
+	identity txcontext.Identity
+	tx       txcontext.Transaction
+}
+
+// validatePOA checks the fields required to register a POA.
+func (svc *POAServiceImpl) validatePOA(POA *entity.POA) error {
+	if POA == nil {
+		return ErrEmptyPOA
+	}
+	err := svc.validatePeriod(POA)
+	if err != nil {
+		return err
+	}
+	if len(POA.AuthorityINN) == 0 {
+		return &ValidationError{Field: "authority_inn", Reason: "required"}
+	}
+	err = validation.INN(POA.AuthorityINN)
+	if err != nil {
+		return &ValidationError{Field: "authority_inn", Reason: err.Error()}
+	}
+	if POA.Principal == nil {
+		return &ValidationError{Field: "principal", Reason: "required"}
+	}
+	err = svc.validateParty("principal", POA.Principal)
+	if err != nil {
+		return err
+	}
+	if len(POA.Representatives) == 0 {
+		return &ValidationError{Field: "representatives", Reason: "required"}
+	}
+	for inx := range POA.Representatives {
+		err = svc.validateParty(fmt.Sprintf("representatives[%d]", inx), &POA.Representatives[inx])
+		if err != nil {
+			return err
+		}
+	}
+	if POA.ConfirmationPolicy != nil {
+		err = svc.validateConfirmationPolicy(POA.ConfirmationPolicy)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePeriod checks that POA dates are ISO-8601 dates and DateFrom is not after DateTo.
+func (svc *POAServiceImpl) validatePeriod(POA *entity.POA) error {
+	if len(POA.DateFrom) == 0 {
+		return &ValidationError{Field: "date_from", Reason: "required"}
+	}
+	if _, err := entity.ParsePOADate(POA.DateFrom); err != nil {
+		return &ValidationError{Field: "date_from", Reason: err.Error()}
+	}
+	if len(POA.DateTo) == 0 {
+		return &ValidationError{Field: "date_to", Reason: "required"}
+	}
+	if _, err := entity.ParsePOADate(POA.DateTo); err != nil {
+		return &ValidationError{Field: "date_to", Reason: err.Error()}
+	}
+	if _, _, err := POA.ValidityPeriod(); err != nil {
+		return &ValidationError{Field: "date_to", Reason: "must not be before date_from"}
+	}
+	return nil
 }
 
-// Create .
-func (svc *POAServiceImpl) Create(POA *entity.POA) (int64, error) {
-    // implement method logic .
-	return 0, errors.New("not implemented")
+// checkNotExpired refuses POA whose validity period is over at the transaction time.
+func (svc *POAServiceImpl) checkNotExpired(POA *entity.POA) error {
+	now, err := svc.clock.Now()
+	if err != nil {
+		return err
+	}
+	status, err := POA.EffectiveStatus(now)
+	if err != nil {
+		return err
+	}
+	if status == entity.POAEffectiveStatusExpired {
+		return ErrPOAExpired
+	}
+	return nil
 }
-// ConfirmAttorney .
-func (svc *POAServiceImpl) ConfirmAttorney(ID string) error {
-    // implement method logic .
-	return errors.New("not implemented")
+
+// validatePowers checks that POA grants only cataloged powers.
+func (svc *POAServiceImpl) validatePowers(POA *entity.POA) error {
+	for inx, p := range POA.Powers {
+		field := fmt.Sprintf("powers[%d].code", inx)
+		if len(p.Code) == 0 {
+			return &ValidationError{Field: field, Reason: "required"}
+		}
+		_, err := svc.rep.PowerRepository().GetByCode(p.Code)
+		if err == repository.ErrPowerNotFound {
+			return &ValidationError{Field: field, Reason: fmt.Sprintf("unknown power code %s", p.Code)}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// validateSubstitution checks POA issued in substitution against its parent POA:
+// the parent is in force and allows substitution, the principal is a parent representative,
+// the period is inside the parent period and the powers are a subset of the parent powers.
+func (svc *POAServiceImpl) validateSubstitution(POA *entity.POA) error {
+	parent, err := svc.rep.POARepository().GetByBlockchainID(POA.ParentID)
+	if err == repository.ErrPOANotFound {
+		return &ValidationError{Field: "parent_id", Reason: fmt.Sprintf("POA %s not found", POA.ParentID)}
+	}
+	if err != nil {
+		return err
+	}
+
+	if !parent.AllowSubstitution {
+		return ErrSubstitutionNotAllowed
+	}
+
+	err = svc.checkParentInForce(parent)
+	if err != nil {
+		return err
+	}
+
+	if !parent.HasRepresentative(POA.Principal.INN) {
+		return &ValidationError{Field: "principal.inn", Reason: "principal is not a representative in parent POA"}
+	}
+
+	from, to, _ := POA.ValidityPeriod()
+	parentFrom, parentTo, err := parent.ValidityPeriod()
+	if err != nil {
+		return err
+	}
+	if from.Before(parentFrom) {
+		return &ValidationError{Field: "date_from", Reason: "must not be before parent POA date_from"}
+	}
+	if to.After(parentTo) {
+		return &ValidationError{Field: "date_to", Reason: "must not be after parent POA date_to"}
+	}
+
+	for inx, p := range POA.Powers {
+		if !parent.HasPower(p.Code) {
+			return &ValidationError{
+				Field:  fmt.Sprintf("powers[%d].code", inx),
+				Reason: fmt.Sprintf("power %s is not granted by parent POA", p.Code),
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkParentInForce refuses parent POA which is not in force at the transaction time.
+func (svc *POAServiceImpl) checkParentInForce(parent *entity.POA) error {
+	now, err := svc.clock.Now()
+	if err != nil {
+		return err
+	}
+	inForce, err := svc.inForce(parent, now)
+	if err != nil {
+		return err
+	}
+	if !inForce {
+		return ErrParentPOANotInForce
+	}
+	return nil
+}
+
+// ancestors returns parent POAs of POA starting from the closest one.
+func (svc *POAServiceImpl) ancestors(POA *entity.POA) ([]entity.POA, error) {
+	var chain []entity.POA
+	for parentID := POA.ParentID; len(parentID) != 0; {
+		if len(chain) == maxDelegationDepth {
+			return nil, ErrDelegationChainTooDeep
+		}
+		parent, err := svc.rep.POARepository().GetByBlockchainID(parentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent POA %s: %s", parentID, err)
+		}
+		chain = append(chain, *parent)
+		parentID = parent.ParentID
+	}
+	return chain, nil
+}
+
+// inForce reports whether POA and all its ancestors are in force at the moment,
+// so revoking or expiring a parent invalidates the descendants.
+func (svc *POAServiceImpl) inForce(POA *entity.POA, at time.Time) (bool, error) {
+	inForce, err := POA.InForce(at)
+	if err != nil || !inForce {
+		return false, err
+	}
+	chain, err := svc.ancestors(POA)
+	if err != nil {
+		return false, err
+	}
+	for inx := range chain {
+		inForce, err = chain[inx].InForce(at)
+		if err != nil || !inForce {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// identifierCheck binds party identifier field to its validator.
+type identifierCheck struct {
+	field    string
+	value    string
+	validate func(string) error
+}
+
+// validateParty checks POA party fields, field is a path of the party in POA.
+func (svc *POAServiceImpl) validateParty(field string, party *entity.Party) error {
+	if !party.IsKnownType() {
+		return &ValidationError{Field: field + ".type", Reason: fmt.Sprintf("unknown party type %q", party.Type)}
+	}
+	if len(party.INN) == 0 {
+		return &ValidationError{Field: field + ".inn", Reason: "required"}
+	}
+
+	var checks []identifierCheck
+	switch party.Type {
+	case entity.PartyTypeLegalEntity:
+		checks = []identifierCheck{
+			{"inn", party.INN, validation.INN10},
+			{"ogrn", party.OGRN, validation.OGRN},
+			{"kpp", party.KPP, validation.KPP},
+		}
+	case entity.PartyTypeIndividualEntrepreneur:
+		checks = []identifierCheck{
+			{"inn", party.INN, validation.INN12},
+			{"ogrn", party.OGRN, validation.OGRNIP},
+			{"snils", party.SNILS, validation.SNILS},
+		}
+	default:
+		checks = []identifierCheck{
+			{"inn", party.INN, validation.INN12},
+			{"snils", party.SNILS, validation.SNILS},
+		}
+	}
+
+	for _, c := range checks {
+		if len(c.value) == 0 {
+			continue
+		}
+		err := c.validate(c.value)
+		if err != nil {
+			return &ValidationError{Field: field + "." + c.field, Reason: err.Error()}
+		}
+	}
+	return nil
+}
+
+// Create validates POA and the principal's signature, puts it into the initial state and saves it.
+// Returns blockchain id of the created POA.
+func (svc *POAServiceImpl) Create(POA *entity.POA) (string, error) {
+	log := logs.WithTags(svc.log, "method", "Create")
+
+	err := svc.validatePOA(POA)
+	if err != nil {
+		return "", err
+	}
+
+	POA.PrincipalMSPID = ""
+	POA.Approvals = nil
+	POA.Audit = nil
+	POA.Revocation = nil
+	err = svc.authorizePrincipal("Create", POA)
+	if err != nil {
+		return "", err
+	}
+	POA.PrincipalMSPID, err = svc.identity.MSPID()
+	if err != nil {
+		return "", fmt.Errorf("failed to get client MSP ID: %s", err)
+	}
+
+	err = svc.validatePowers(POA)
+	if err != nil {
+		return "", err
+	}
+
+	if len(POA.ParentID) != 0 {
+		err = svc.validateSubstitution(POA)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	now, err := svc.clock.Now()
+	if err != nil {
+		return "", err
+	}
+	if POA.Signature != nil {
+		POA.Signature.Fingerprint = ""
+		POA.Signature.VerifiedAt = ""
+	}
+	fingerprint, err := svc.verifySignature(POA, now)
+	if err != nil {
+		return "", err
+	}
+	POA.Signature.Fingerprint = fingerprint
+	POA.Signature.VerifiedAt = now.Format(time.RFC3339)
+
+	err = POA.SetStateCreated()
+	if err != nil {
+		return "", err
+	}
+
+	err = svc.audit(POA, "Create", "", "")
+	if err != nil {
+		return "", err
+	}
+
+	id, err := svc.rep.POARepository().New(POA)
+	if err != nil {
+		return "", fmt.Errorf("failed to save POA: %s", err)
+	}
+
+	log.Infof("POA %s created", id)
+
+	return id, nil
+}
+
+// transit loads POA by blockchain id, applies state transition, records it in the audit trail and saves POA.
+// Expired POA is refused by every transition. Transition returning errNoChange is not recorded.
+func (svc *POAServiceImpl) transit(ID string, transition string, comment string, setState func(*entity.POA) error) error {
+	if len(ID) == 0 {
+		return &ValidationError{Field: "id", Reason: "required"}
+	}
+
+	POA, err := svc.rep.POARepository().GetByBlockchainID(ID)
+	if err != nil {
+		return err
+	}
+
+	err = svc.validatePOA(POA)
+	if err != nil {
+		return err
+	}
+
+	if !POA.IsTerminal() {
+		err = svc.checkNotExpired(POA)
+		if err != nil {
+			return err
+		}
+	}
+
+	from := POA.State
+	err = setState(POA)
+	if err == errNoChange {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = svc.audit(POA, transition, from, comment)
+	if err != nil {
+		return err
+	}
+
+	err = svc.rep.POARepository().Update(POA)
+	if err != nil {
+		return fmt.Errorf("failed to save POA: %s", err)
+	}
+
+	return nil
+}
+
+// ConfirmAttorney moves sent POA into state Confirmed unless it is expired
+// or, for POA issued in substitution, its parent is no longer in force.
+// Only clients having the confirmer role may confirm. POA with confirmation policy
+// records the approval of the client's organization and is confirmed once enough organizations approved.
+func (svc *POAServiceImpl) ConfirmAttorney(ID string, Comment string) error {
+	err := svc.authorizeRole("ConfirmAttorney", RoleConfirmer)
+	if err != nil {
+		return err
+	}
+
+	return svc.transit(ID, "ConfirmAttorney", Comment, func(POA *entity.POA) error {
+		if len(POA.ParentID) != 0 {
+			parent, err := svc.rep.POARepository().GetByBlockchainID(POA.ParentID)
+			if err != nil {
+				return fmt.Errorf("failed to get parent POA %s: %s", POA.ParentID, err)
+			}
+			err = svc.checkParentInForce(parent)
+			if err != nil {
+				return err
+			}
+		}
+		if POA.ConfirmationPolicy != nil {
+			return svc.approve(POA)
+		}
+		return POA.SetStateConfirmed()
+	})
+}
+
+// SendAttorney sends created or returned POA for approval.
+// Only clients of the principal's organization may send.
+func (svc *POAServiceImpl) SendAttorney(ID string, Comment string) error {
+	return svc.transit(ID, "SendAttorney", Comment, func(POA *entity.POA) error {
+		err := svc.authorizePrincipal("SendAttorney", POA)
+		if err != nil {
+			return err
+		}
+		return POA.SetStateSent()
+	})
+}
+
+// ReturnAttorney returns sent POA for revision, only clients having the confirmer role may return.
+// Approvals collected so far are discarded, revised POA is approved anew once sent again.
+func (svc *POAServiceImpl) ReturnAttorney(ID string, Comment string) error {
+	err := svc.authorizeRole("ReturnAttorney", RoleConfirmer)
+	if err != nil {
+		return err
+	}
+
+	return svc.transit(ID, "ReturnAttorney", Comment, func(POA *entity.POA) error {
+		err := POA.SetStateReturned()
+		if err != nil {
+			return err
+		}
+		POA.Approvals = nil
+		return nil
+	})
+}
+
+// RejectAttorney moves sent POA into state Rejected, only clients having the confirmer role may reject.
+func (svc *POAServiceImpl) RejectAttorney(ID string, Comment string) error {
+	err := svc.authorizeRole("RejectAttorney", RoleConfirmer)
+	if err != nil {
+		return err
+	}
+
+	return svc.transit(ID, "RejectAttorney", Comment, (*entity.POA).SetStateRejected)
+}
+
+// RevokeAttorney revokes confirmed POA recording the reason, the revoker and the transaction time.
+// Only clients of the principal's organization may revoke.
+func (svc *POAServiceImpl) RevokeAttorney(ID string, Reason string) error {
+	if len(Reason) == 0 {
+		return &ValidationError{Field: "reason", Reason: "required"}
+	}
+
+	return svc.transit(ID, "RevokeAttorney", Reason, func(POA *entity.POA) error {
+		err := svc.authorizePrincipal("RevokeAttorney", POA)
+		if err != nil {
+			return err
+		}
+
+		err = POA.SetStateRevoked()
+		if err != nil {
+			return err
+		}
+
+		now, err := svc.clock.Now()
+		if err != nil {
+			return err
+		}
+		mspID, err := svc.identity.MSPID()
+		if err != nil {
+			return fmt.Errorf("failed to get revoker identity: %s", err)
+		}
+		revokerID, err := svc.identity.ID()
+		if err != nil {
+			return fmt.Errorf("failed to get revoker identity: %s", err)
+		}
+
+		POA.Revocation = &entity.Revocation{
+			Reason:       Reason,
+			RevokerMSPID: mspID,
+			RevokerID:    revokerID,
+			Timestamp:    now.Format(time.RFC3339),
+		}
+		return nil
+	})
+}
+
+// DelegationChain returns POA followed by its parent POAs up to the root one.
+func (svc *POAServiceImpl) DelegationChain(ID string) ([]entity.POA, error) {
+	if len(ID) == 0 {
+		return nil, &ValidationError{Field: "id", Reason: "required"}
+	}
+
+	POA, err := svc.rep.POARepository().GetByBlockchainID(ID)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, err := svc.ancestors(POA)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]entity.POA{*POA}, chain...), nil
+}
+
+// GetApprovals returns approvals POA collected under its confirmation policy.
+func (svc *POAServiceImpl) GetApprovals(ID string) ([]entity.Approval, error) {
+	if len(ID) == 0 {
+		return nil, &ValidationError{Field: "id", Reason: "required"}
+	}
+
+	POA, err := svc.rep.POARepository().GetByBlockchainID(ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return POA.Approvals, nil
+}
+
+// GetAuditTrail returns the audit records of POA state changes in the order they were made.
+func (svc *POAServiceImpl) GetAuditTrail(ID string) ([]entity.AuditRecord, error) {
+	if len(ID) == 0 {
+		return nil, &ValidationError{Field: "id", Reason: "required"}
+	}
+
+	POA, err := svc.rep.POARepository().GetByBlockchainID(ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return POA.Audit, nil
+}
+
+const (
+	// DefaultPageSize is the page size of searches which do not set it.
+	DefaultPageSize = 50
+	// MaxPageSize limits the page size of searches.
+	MaxPageSize = 500
+)
+
+// FindAttorneys returns page of POAs matching the search request.
+// The bookmark of the page continues the search with the next page.
+func (svc *POAServiceImpl) FindAttorneys(Request *entity.POASearchRequest) (*entity.POAPage, error) {
+	if Request == nil {
+		return nil, &ValidationError{Field: "request", Reason: "required"}
+	}
+	if Request.PageSize < 0 || Request.PageSize > MaxPageSize {
+		return nil, &ValidationError{Field: "page_size", Reason: fmt.Sprintf("must be between 0 and %d, 0 means default", MaxPageSize)}
+	}
+	if err := validateDateRange("date_from_range", Request.DateFromRange); err != nil {
+		return nil, err
+	}
+	if err := validateDateRange("date_to_range", Request.DateToRange); err != nil {
+		return nil, err
+	}
+	if Request.ValidAt != nil {
+		if _, err := entity.ParsePOADate(*Request.ValidAt); err != nil {
+			return nil, &ValidationError{Field: "valid_at", Reason: err.Error()}
+		}
+	}
+
+	request := *Request
+	if request.PageSize == 0 {
+		request.PageSize = DefaultPageSize
+	}
+
+	page, err := svc.rep.POARepository().Find(&request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find POAs: %s", err)
+	}
+
+	return page, nil
+}
+
+// VerifyAuthority answers whether the representative may exercise the power on behalf of the principal
+// at the moment given as ISO-8601 timestamp. POA versions at the moment are taken from the key history.
+// Transaction time and current POA versions are used when timestamp is empty.
+func (svc *POAServiceImpl) VerifyAuthority(RepresentativeINN string, PrincipalINN string, PowerCode string, Timestamp string) (*entity.AuthorityVerdict, error) {
+	if len(RepresentativeINN) == 0 {
+		return nil, &ValidationError{Field: "representative_inn", Reason: "required"}
+	}
+	if len(PrincipalINN) == 0 {
+		return nil, &ValidationError{Field: "principal_inn", Reason: "required"}
+	}
+	if len(PowerCode) == 0 {
+		return nil, &ValidationError{Field: "power_code", Reason: "required"}
+	}
+
+	var (
+		at   time.Time
+		err  error
+		load = svc.rep.POARepository().GetByBlockchainID
+	)
+	if len(Timestamp) != 0 {
+		at, err = entity.ParsePOADate(Timestamp)
+		if err != nil {
+			return nil, &ValidationError{Field: "timestamp", Reason: err.Error()}
+		}
+		load = func(ID string) (*entity.POA, error) {
+			return svc.rep.POARepository().GetByBlockchainIDAt(ID, at)
+		}
+	} else {
+		at, err = svc.clock.Now()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	candidates, err := svc.rep.POARepository().Find(&entity.POASearchRequest{RepresentativeINN: &RepresentativeINN})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find POAs: %s", err)
+	}
+
+	verdict := &entity.AuthorityVerdict{At: at.Format(time.RFC3339)}
+
+	var reasons []string
+	for _, candidate := range candidates.POAs {
+		chain, reason, err := svc.justify(candidate.BlockchainID, PrincipalINN, PowerCode, at, load)
+		if err != nil {
+			return nil, err
+		}
+		if chain != nil {
+			verdict.Authorized = true
+			verdict.POAs = chain
+			return verdict, nil
+		}
+		reasons = append(reasons, reason)
+	}
+
+	if len(reasons) == 0 {
+		reasons = append(reasons, fmt.Sprintf("no POA granted to representative %s", RepresentativeINN))
+	}
+	verdict.Reason = strings.Join(reasons, "; ")
+
+	return verdict, nil
+}
+
+// justify walks from POA granted to the representative through its parents up to POA granted
+// by the principal. Every POA in the chain must grant the power, be in force at the moment
+// and keep a valid principal's signature.
+// Returns the chain, or the reason why the POA does not justify authority.
+func (svc *POAServiceImpl) justify(ID string, principalINN string, powerCode string, at time.Time,
+	load func(string) (*entity.POA, error)) ([]entity.POA, string, error) {
+	var chain []entity.POA
+	for {
+		if len(chain) == maxDelegationDepth {
+			return nil, "", ErrDelegationChainTooDeep
+		}
+
+		POA, err := load(ID)
+		if err == repository.ErrPOANotFound {
+			return nil, fmt.Sprintf("POA %s does not exist at %s", ID, at.Format(time.RFC3339)), nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if !POA.HasPower(powerCode) {
+			return nil, fmt.Sprintf("POA %s does not grant power %s", ID, powerCode), nil
+		}
+		inForce, err := POA.InForce(at)
+		if err != nil {
+			return nil, "", err
+		}
+		if !inForce {
+			return nil, fmt.Sprintf("POA %s in state %s is not in force", ID, POA.State), nil
+		}
+		err = svc.recheckSignature(POA)
+		if err != nil {
+			return nil, fmt.Sprintf("POA %s %s", ID, err), nil
+		}
+
+		chain = append(chain, *POA)
+
+		if POA.Principal != nil && POA.Principal.INN == principalINN {
+			return chain, "", nil
+		}
+		if len(POA.ParentID) == 0 {
+			return nil, fmt.Sprintf("POA %s is not granted by principal %s", ID, principalINN), nil
+		}
+		ID = POA.ParentID
+	}
+}
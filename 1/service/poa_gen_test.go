@@ -1,15 +1,155 @@
 package service
 
 import (
+	"crypto/x509"
+	"errors"
 	"testing"
+	"time"
     "github.com/procsy-tech/attorney/dto"
+	"github.com/procsy-tech/attorney/entity"
 	"github.com/procsy-tech/attorney/repository"
+	"github.com/procsy-tech/attorney/signature"
+	"github.com/procsy-tech/attorney/txcontext"
 	"github.com/kbkontrakt/hlfabric-ccdevkit/logs"
 	. "github.com/smartystreets/goconvey/convey"
 
 	gomock "github.com/golang/mock/gomock"
 )
 
+// txClock returns transaction time inside validPOA period.
+var txClock = txcontext.ClockFunc(func() (time.Time, error) {
+	return time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC), nil
+})
+
+// testTransaction is the transaction id.
+type testTransaction string
+
+func (t testTransaction) ID() string { return string(t) }
+
+// txTransaction is the transaction of all test calls.
+var txTransaction = testTransaction("tx1")
+
+// testIdentity is the transaction creator identity.
+type testIdentity struct {
+	id    string
+	mspID string
+	attrs map[string]string
+	ous   []string
+}
+
+func (i *testIdentity) ID() (string, error)    { return i.id, nil }
+func (i *testIdentity) MSPID() (string, error) { return i.mspID, nil }
+
+func (i *testIdentity) Attribute(name string) (string, bool, error) {
+	value, found := i.attrs[name]
+	return value, found, nil
+}
+
+// Certificate returns the test CA certificate for every client.
+func (i *testIdentity) Certificate() (*x509.Certificate, error) {
+	return testCA.cert, nil
+}
+
+func (i *testIdentity) HasOU(ou string) (bool, error) {
+	for _, value := range i.ous {
+		if value == ou {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// txIdentity acts for the principal of validPOA and has the confirmer role.
+var txIdentity = &testIdentity{
+	id:    "x509::CN=user1::CN=ca",
+	mspID: "Org1MSP",
+	attrs: map[string]string{AttrOrganizationINN: "7707083893", AttrRole: RoleConfirmer},
+}
+
+// principalIdentity returns identity of Org1MSP client acting for the party, without roles.
+func principalIdentity(inn string) *testIdentity {
+	return &testIdentity{
+		id:    "x509::CN=user2::CN=ca",
+		mspID: "Org1MSP",
+		attrs: map[string]string{AttrOrganizationINN: inn},
+	}
+}
+
+// confirmerIdentity returns identity of the organization client having the confirmer role.
+func confirmerIdentity(mspID string) *testIdentity {
+	return &testIdentity{
+		id:    "x509::CN=confirmer::CN=ca",
+		mspID: mspID,
+		attrs: map[string]string{AttrRole: RoleConfirmer},
+	}
+}
+
+// validPOA returns POA passing service validation.
+func validPOA() *entity.POA {
+	return &entity.POA{
+		DateFrom:     "2021-01-01",
+		DateTo:       "2021-12-31",
+		AuthorityINN: "7707083893",
+		Principal: &entity.Party{
+			Type: entity.PartyTypeLegalEntity,
+			INN:  "7707083893",
+			OGRN: "1027700132195",
+			KPP:  "773601001",
+			Name: "ПАО Сбербанк",
+		},
+		Representatives: []entity.Party{{
+			Type:       entity.PartyTypeIndividual,
+			INN:        "500100732259",
+			SNILS:      "11223344595",
+			LastName:   "Иванов",
+			FirstName:  "Иван",
+			MiddleName: "Иванович",
+		}},
+	}
+}
+
+// storedPOA returns valid POA as it is kept in the ledger.
+func storedPOA(state entity.POAState) *entity.POA {
+	e := validPOA()
+	e.BlockchainID = "POA1"
+	e.State = state
+	e.PrincipalMSPID = "Org1MSP"
+	return e
+}
+
+// catalog is the powers catalog kept in the ledger.
+var catalog = []entity.PowerDefinition{
+	{Code: "SIGN", Description: "подписывать документы"},
+	{Code: "PAY", Description: "совершать платежи"},
+	{Code: "SELL", Description: "продавать имущество"},
+}
+
+// substitutionPOA returns POA issued in substitution of storedPOA.
+func substitutionPOA() *entity.POA {
+	e := validPOA()
+	e.ParentID = "POA1"
+	e.DateFrom = "2021-02-01"
+	e.DateTo = "2021-11-30"
+	e.Principal = &entity.Party{
+		Type: entity.PartyTypeIndividual,
+		INN:  "500100732259",
+	}
+	e.Representatives = []entity.Party{{
+		Type: entity.PartyTypeIndividual,
+		INN:  "771234567859",
+	}}
+	e.Powers = []entity.Power{{Code: "SIGN"}}
+	return e
+}
+
+// substitutedPOA returns confirmed parent POA for substitutionPOA.
+func substitutedPOA() *entity.POA {
+	e := storedPOA(entity.POAStateConfirmed)
+	e.AllowSubstitution = true
+	e.Powers = []entity.Power{{Code: "SIGN"}, {Code: "PAY"}}
+	return e
+}
+
 func TestPOAServiceCreate(t *testing.T) {
 	Convey("POA Create", t, func(c C) {
 		// prepare dummy service .
@@ -17,23 +157,320 @@ func TestPOAServiceCreate(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
+		poaRep := repository.NewMockPOARepository(ctrl)
+		powerRep := repository.NewMockPowerRepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().POARepository().Return(poaRep).AnyTimes()
+		rep.EXPECT().PowerRepository().Return(powerRep).AnyTimes()
+		anchorRep := repository.NewMockTrustAnchorRepository(ctrl)
+		rep.EXPECT().TrustAnchorRepository().Return(anchorRep).AnyTimes()
+		anchorRep.EXPECT().List().Return(testAnchors, nil).AnyTimes()
+		powerRep.EXPECT().GetByCode(gomock.Any()).DoAndReturn(func(code string) (*entity.PowerDefinition, error) {
+			for _, p := range catalog {
+				if p.Code == code {
+					return &p, nil
+				}
+			}
+			return nil, repository.ErrPowerNotFound
+		}).AnyTimes()
+
 		svc := NewPOAServiceImpl(
 			logs.DummyLogger(),
-			repository.NewMockRepository(ctrl),
+			rep,
+			txClock,
+			txIdentity,
+			txTransaction,
 		)
 
 		c.Convey("Given POAService", func(c C) {
-			c.Convey("When invoking method Create", func(c C) {
-				// test logic needs to be implemented .
-				
+			c.Convey("When invoking method Create with empty request", func(c C) {
 				var (
 					request    = &dto.CreateRequest{}
 				)
+    			c.Convey("It should return error", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldEqual, ErrEmptyPOA)
+				})
+			})
+			c.Convey("When invoking method Create without required field", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: &entity.POA{
+						DateFrom: "2021-01-01",
+						DateTo:   "2021-12-31",
+					}}
+				)
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "authority_inn")
+				})
+			})
+			c.Convey("When invoking method Create with representative of unknown type", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+				request.POA.Representatives[0].Type = "Unknown"
+    			c.Convey("It should return validation error naming the party", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "representatives[0].type")
+				})
+			})
+			c.Convey("When invoking method Create with DateTo before DateFrom", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+				request.POA.DateTo = "2020-12-31"
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "date_to")
+				})
+			})
+			c.Convey("When invoking method Create with non ISO-8601 DateFrom", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+				request.POA.DateFrom = "01.01.2021"
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "date_from")
+				})
+			})
+			c.Convey("When invoking method Create with wrong principal OGRN", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+				request.POA.Principal.OGRN = "1027700132194"
+    			c.Convey("It should return validation error naming the field", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "principal.ogrn")
+				})
+			})
+			c.Convey("When invoking method Create with 12-digit INN of legal entity", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+				request.POA.Principal.INN = "500100732259"
+    			c.Convey("It should return validation error naming the field", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "principal.inn")
+				})
+			})
+			c.Convey("When invoking method Create without principal", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+				request.POA.Principal = nil
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "principal")
+				})
+			})
+			c.Convey("When invoking method Create with unknown power code", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+				request.POA.Powers = []entity.Power{{Code: "SIGN"}, {Code: "FLY", Description: "пилотировать"}}
+    			c.Convey("It should return validation error naming the power", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "powers[1].code")
+				})
+			})
+			c.Convey("When invoking method Create in substitution", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: substitutionPOA()}
+					parent     = substitutedPOA()
+					identity   = principalIdentity("500100732259")
+					svc        = NewPOAServiceImpl(logs.DummyLogger(), rep, txClock, identity, txTransaction)
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(parent, nil).AnyTimes()
+				c.Convey("and parent does not allow substitution", func(c C) {
+					parent.AllowSubstitution = false
+					_, err := svc.Create(request.POA)
+					So(err, ShouldEqual, ErrSubstitutionNotAllowed)
+				})
+				c.Convey("and parent is revoked", func(c C) {
+					parent.State = entity.POAStateRevoked
+					_, err := svc.Create(request.POA)
+					So(err, ShouldEqual, ErrParentPOANotInForce)
+				})
+				c.Convey("and period is outside of parent period", func(c C) {
+					request.POA.DateTo = "2022-01-31"
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "date_to")
+				})
+				c.Convey("and powers are not granted by parent", func(c C) {
+					request.POA.Powers = append(request.POA.Powers, entity.Power{Code: "SELL"})
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "powers[1].code")
+				})
+				c.Convey("and principal is not parent representative", func(c C) {
+					request.POA.Principal.INN = "771234567859"
+					identity.attrs[AttrOrganizationINN] = "771234567859"
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "principal.inn")
+				})
+				c.Convey("and it is inside parent scope", func(c C) {
+					signed(request.POA)
+					poaRep.EXPECT().New(request.POA).Return("POA2", nil)
+					id, err := svc.Create(request.POA)
+					So(err, ShouldBeNil)
+					So(id, ShouldEqual, "POA2")
+				})
+			})
+			c.Convey("When invoking method Create by client acting for another party", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+					svc        = NewPOAServiceImpl(logs.DummyLogger(), rep, txClock, principalIdentity("500100732259"), txTransaction)
+				)
+    			c.Convey("It should deny access", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &AccessDeniedError{})
+				})
+			})
+			c.Convey("When invoking method Create without signature", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "signature")
+				})
+			})
+			c.Convey("When invoking method Create with signature made by representative", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+				signedBy(request.POA, "500100732259")
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "signature.certificate")
+				})
+			})
+			c.Convey("When invoking method Create with document changed after signing", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: signed(validPOA())}
+				)
+				request.POA.DateTo = "2021-12-30"
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "signature")
+				})
+			})
+			c.Convey("When invoking method Create with signature container", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: signedContainer(validPOA())}
+				)
+				poaRep.EXPECT().New(request.POA).Return("POA1609459200ABCDEF01", nil)
+    			c.Convey("It should save POA with signer fingerprint", func(c C) {
+					id, err := svc.Create(request.POA)
+					So(err, ShouldBeNil)
+					So(id, ShouldEqual, "POA1609459200ABCDEF01")
+					So(request.POA.Signature.Fingerprint, ShouldEqual, signed(validPOA()).Signature.Fingerprint)
+					So(request.POA.Audit, ShouldHaveLength, 1)
+					So(request.POA.Audit[0].Transition, ShouldEqual, "Create")
+					So(string(request.POA.Audit[0].To), ShouldEqual, entity.POAStateCreated)
+				})
+			})
+			c.Convey("When invoking method Create with revocation", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: signed(validPOA())}
+				)
+				request.POA.Revocation = &entity.Revocation{Reason: "forged", RevokerMSPID: "Org2MSP", Timestamp: "2021-01-01T00:00:00Z"}
+				poaRep.EXPECT().New(gomock.Any()).DoAndReturn(func(e *entity.POA) (string, error) {
+					So(e.Revocation, ShouldBeNil)
+					So(string(e.State), ShouldEqual, entity.POAStateCreated)
+					return "POA1", nil
+				})
+    			c.Convey("It should save POA without the revocation", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldBeNil)
+				})
+			})
+			c.Convey("When invoking method Create with signature container of changed document", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: signedContainer(validPOA())}
+				)
+				request.POA.DateTo = "2021-12-30"
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "signature.container")
+				})
+			})
+			c.Convey("When invoking method Create with confirmation policy which can not be met", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+				request.POA.ConfirmationPolicy = &entity.ConfirmationPolicy{Required: 3, MSPIDs: []string{"Org1MSP", "Org2MSP"}}
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "confirmation_policy.required")
+				})
+			})
+			c.Convey("When invoking method Create with duplicate organizations in confirmation policy", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+				request.POA.ConfirmationPolicy = &entity.ConfirmationPolicy{Required: 1, MSPIDs: []string{"Org1MSP", "Org1MSP"}}
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "confirmation_policy.msp_ids[1]")
+				})
+			})
+			c.Convey("When invoking method Create with confirmation policy and approvals", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: validPOA()}
+				)
+				request.POA.ConfirmationPolicy = &entity.ConfirmationPolicy{Required: 2, MSPIDs: []string{"Org1MSP", "Org2MSP"}}
+				signed(request.POA)
+				request.POA.Approvals = []entity.Approval{{MSPID: "Org1MSP"}, {MSPID: "Org2MSP"}}
+				poaRep.EXPECT().New(request.POA).Return("POA1", nil)
+    			c.Convey("It should save POA without approvals", func(c C) {
+					_, err := svc.Create(request.POA)
+					So(err, ShouldBeNil)
+					So(request.POA.Approvals, ShouldBeEmpty)
+				})
+			})
+			c.Convey("When invoking method Create and repository fails", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: signed(validPOA())}
+				)
+				poaRep.EXPECT().New(gomock.Any()).Return("", errors.New("put state failed"))
     			c.Convey("It should return error", func(c C) {
 					_, err := svc.Create(request.POA)
 					So(err, ShouldNotBeNil)
 				})
 			})
+			c.Convey("When invoking method Create with valid POA", func(c C) {
+				var (
+					request    = &dto.CreateRequest{POA: signed(validPOA())}
+				)
+				poaRep.EXPECT().New(request.POA).Return("POA1609459200ABCDEF01", nil)
+    			c.Convey("It should save POA in state Created and return its id", func(c C) {
+					id, err := svc.Create(request.POA)
+					So(err, ShouldBeNil)
+					So(id, ShouldEqual, "POA1609459200ABCDEF01")
+					So(string(request.POA.State), ShouldEqual, entity.POAStateCreated)
+					So(request.POA.PrincipalMSPID, ShouldEqual, "Org1MSP")
+					So(request.POA.Signature.Fingerprint, ShouldEqual, signed(validPOA()).Signature.Fingerprint)
+					So(request.POA.Signature.VerifiedAt, ShouldEqual, "2021-06-01T12:00:00Z")
+				})
+			})
 		})
 	})
 }
@@ -44,24 +481,838 @@ func TestPOAServiceConfirmAttorney(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
+		poaRep := repository.NewMockPOARepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().POARepository().Return(poaRep).AnyTimes()
+
 		svc := NewPOAServiceImpl(
 			logs.DummyLogger(),
-			repository.NewMockRepository(ctrl),
+			rep,
+			txClock,
+			txIdentity,
+			txTransaction,
 		)
 
 		c.Convey("Given POAService", func(c C) {
-			c.Convey("When invoking method ConfirmAttorney", func(c C) {
-				// test logic needs to be implemented .
-				
+			c.Convey("When invoking method ConfirmAttorney with empty id", func(c C) {
 				var (
 					request    = &dto.ConfirmAttorneyRequest{}
 				)
+    			c.Convey("It should return validation error", func(c C) {
+					err := svc.ConfirmAttorney(request.ID, request.Comment)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+				})
+			})
+			c.Convey("When invoking method ConfirmAttorney for POA with invalid representative SNILS", func(c C) {
+				var (
+					request    = &dto.ConfirmAttorneyRequest{ID: "POA1"}
+					stored     = storedPOA(entity.POAStateSent)
+				)
+				stored.Representatives[0].SNILS = "11223344596"
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(stored, nil)
+    			c.Convey("It should return validation error naming the field", func(c C) {
+					err := svc.ConfirmAttorney(request.ID, request.Comment)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "representatives[0].snils")
+				})
+			})
+			c.Convey("When invoking method ConfirmAttorney for expired POA", func(c C) {
+				var (
+					request    = &dto.ConfirmAttorneyRequest{ID: "POA1"}
+					stored     = storedPOA(entity.POAStateSent)
+				)
+				stored.DateTo = "2021-05-31"
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(stored, nil)
+    			c.Convey("It should return expired error", func(c C) {
+					err := svc.ConfirmAttorney(request.ID, request.Comment)
+					So(err, ShouldEqual, ErrPOAExpired)
+				})
+			})
+			c.Convey("When invoking method ConfirmAttorney for POA which is not yet valid", func(c C) {
+				var (
+					request    = &dto.ConfirmAttorneyRequest{ID: "POA1"}
+					stored     = storedPOA(entity.POAStateSent)
+				)
+				stored.DateFrom = "2021-07-01"
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(stored, nil)
+				poaRep.EXPECT().Update(stored).Return(nil)
+    			c.Convey("It should confirm it", func(c C) {
+					err := svc.ConfirmAttorney(request.ID, request.Comment)
+					So(err, ShouldBeNil)
+				})
+			})
+			c.Convey("When invoking method ConfirmAttorney for unknown POA", func(c C) {
+				var (
+					request    = &dto.ConfirmAttorneyRequest{ID: "POA1"}
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(nil, repository.ErrPOANotFound)
+    			c.Convey("It should return not found error", func(c C) {
+					err := svc.ConfirmAttorney(request.ID, request.Comment)
+					So(err, ShouldEqual, repository.ErrPOANotFound)
+				})
+			})
+			c.Convey("When invoking method ConfirmAttorney for POA in state eCreated", func(c C) {
+				var (
+					request    = &dto.ConfirmAttorneyRequest{ID: "POA1"}
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(storedPOA(entity.POAStateCreated), nil)
+    			c.Convey("It should return error", func(c C) {
+					err := svc.ConfirmAttorney(request.ID, request.Comment)
+					So(err, ShouldNotBeNil)
+				})
+			})
+			c.Convey("When invoking method ConfirmAttorney for POA in state eSent", func(c C) {
+				var (
+					request    = &dto.ConfirmAttorneyRequest{ID: "POA1"}
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(storedPOA(entity.POAStateSent), nil)
+				poaRep.EXPECT().Update(gomock.Any()).DoAndReturn(func(e *entity.POA) error {
+					So(string(e.State), ShouldEqual, entity.POAStateConfirmed)
+					return nil
+				})
+    			c.Convey("It should save POA in state Confirmed", func(c C) {
+					err := svc.ConfirmAttorney(request.ID, request.Comment)
+					So(err, ShouldBeNil)
+				})
+				c.Convey("It should allow client having confirmer OU", func(c C) {
+					identity := principalIdentity("7707083893")
+					identity.ous = []string{RoleConfirmer}
+					svc := NewPOAServiceImpl(logs.DummyLogger(), rep, txClock, identity, txTransaction)
+					err := svc.ConfirmAttorney(request.ID, request.Comment)
+					So(err, ShouldBeNil)
+				})
+			})
+			c.Convey("When invoking method ConfirmAttorney for POA requiring approvals of two organizations", func(c C) {
+				var (
+					request    = &dto.ConfirmAttorneyRequest{ID: "POA1"}
+					stored     = storedPOA(entity.POAStateSent)
+				)
+				stored.ConfirmationPolicy = &entity.ConfirmationPolicy{Required: 2, MSPIDs: []string{"Org1MSP", "Org2MSP", "Org3MSP"}}
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(stored, nil).AnyTimes()
+    			c.Convey("It should record the first approval and keep POA sent", func(c C) {
+					poaRep.EXPECT().Update(stored).Return(nil)
+					err := svc.ConfirmAttorney(request.ID, request.Comment)
+					So(err, ShouldBeNil)
+					So(string(stored.State), ShouldEqual, entity.POAStateSent)
+					So(stored.Approvals, ShouldResemble, []entity.Approval{
+						{MSPID: "Org1MSP", ApproverID: "x509::CN=user1::CN=ca", Timestamp: "2021-06-01T12:00:00Z"},
+					})
+
+					c.Convey("and ignore repeated approval of the same organization", func(c C) {
+						audit := stored.Audit
+						err := svc.ConfirmAttorney(request.ID, request.Comment)
+						So(err, ShouldBeNil)
+						So(stored.Approvals, ShouldHaveLength, 1)
+						So(stored.Audit, ShouldResemble, audit)
+						So(string(stored.State), ShouldEqual, entity.POAStateSent)
+					})
+					c.Convey("and confirm POA on approval of the second organization", func(c C) {
+						poaRep.EXPECT().Update(stored).Return(nil)
+						svc := NewPOAServiceImpl(logs.DummyLogger(), rep, txClock, confirmerIdentity("Org3MSP"), txTransaction)
+						err := svc.ConfirmAttorney(request.ID, request.Comment)
+						So(err, ShouldBeNil)
+						So(stored.Approvals, ShouldHaveLength, 2)
+						So(string(stored.State), ShouldEqual, entity.POAStateConfirmed)
+
+						c.Convey("and ignore repeated approval of confirmed POA", func(c C) {
+							err := svc.ConfirmAttorney(request.ID, request.Comment)
+							So(err, ShouldBeNil)
+							So(stored.Audit, ShouldHaveLength, 2)
+							So(string(stored.State), ShouldEqual, entity.POAStateConfirmed)
+						})
+					})
+				})
+				c.Convey("It should deny approval of not eligible organization", func(c C) {
+					svc := NewPOAServiceImpl(logs.DummyLogger(), rep, txClock, confirmerIdentity("Org4MSP"), txTransaction)
+					err := svc.ConfirmAttorney(request.ID, request.Comment)
+					So(err, ShouldHaveSameTypeAs, &AccessDeniedError{})
+					So(stored.Approvals, ShouldBeEmpty)
+				})
+			})
+			c.Convey("When invoking method ConfirmAttorney by client without confirmer role", func(c C) {
+				var (
+					request    = &dto.ConfirmAttorneyRequest{ID: "POA1"}
+					svc        = NewPOAServiceImpl(logs.DummyLogger(), rep, txClock, principalIdentity("7707083893"), txTransaction)
+				)
+    			c.Convey("It should deny access", func(c C) {
+					err := svc.ConfirmAttorney(request.ID, request.Comment)
+					So(err, ShouldHaveSameTypeAs, &AccessDeniedError{})
+					So(IsAccessDenied(err), ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
+func TestPOAServiceSendAttorney(t *testing.T) {
+	Convey("POA SendAttorney", t, func(c C) {
+		// prepare dummy service .
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		poaRep := repository.NewMockPOARepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().POARepository().Return(poaRep).AnyTimes()
+
+		svc := NewPOAServiceImpl(
+			logs.DummyLogger(),
+			rep,
+			txClock,
+			txIdentity,
+			txTransaction,
+		)
+
+		c.Convey("Given POAService", func(c C) {
+			c.Convey("When invoking method SendAttorney for POA in state eConfirmed", func(c C) {
+				var (
+					request    = &dto.SendAttorneyRequest{ID: "POA1"}
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(storedPOA(entity.POAStateConfirmed), nil)
+    			c.Convey("It should return error", func(c C) {
+					err := svc.SendAttorney(request.ID, request.Comment)
+					So(err, ShouldNotBeNil)
+				})
+			})
+			c.Convey("When invoking method SendAttorney for POA in state eReturned", func(c C) {
+				var (
+					request    = &dto.SendAttorneyRequest{ID: "POA1", Comment: "powers fixed"}
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(storedPOA(entity.POAStateReturned), nil)
+				poaRep.EXPECT().Update(gomock.Any()).DoAndReturn(func(e *entity.POA) error {
+					So(string(e.State), ShouldEqual, entity.POAStateSent)
+					So(e.Audit, ShouldResemble, []entity.AuditRecord{{
+						Transition:  "SendAttorney",
+						From:        entity.POAStateReturned,
+						To:          entity.POAStateSent,
+						MSPID:       "Org1MSP",
+						Subject:     "CN=Test CA",
+						Fingerprint: signature.Fingerprint(testCA.cert),
+						TxID:        "tx1",
+						Timestamp:   "2021-06-01T12:00:00Z",
+						Comment:     "powers fixed",
+					}})
+					return nil
+				})
+    			c.Convey("It should save POA in state Sent", func(c C) {
+					err := svc.SendAttorney(request.ID, request.Comment)
+					So(err, ShouldBeNil)
+				})
+			})
+			c.Convey("When invoking method SendAttorney by client acting for other party", func(c C) {
+				var (
+					request    = &dto.SendAttorneyRequest{ID: "POA1"}
+					stored     = storedPOA(entity.POAStateCreated)
+					svc        = NewPOAServiceImpl(logs.DummyLogger(), rep, txClock, principalIdentity("500100732259"), txTransaction)
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(stored, nil)
+    			c.Convey("It should deny access", func(c C) {
+					err := svc.SendAttorney(request.ID, request.Comment)
+					So(err, ShouldHaveSameTypeAs, &AccessDeniedError{})
+					So(string(stored.State), ShouldEqual, entity.POAStateCreated)
+				})
+			})
+			c.Convey("When invoking method SendAttorney by principal's client of other MSP", func(c C) {
+				var (
+					request    = &dto.SendAttorneyRequest{ID: "POA1"}
+					identity   = principalIdentity("7707083893")
+				)
+				identity.mspID = "Org2MSP"
+				svc := NewPOAServiceImpl(logs.DummyLogger(), rep, txClock, identity, txTransaction)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(storedPOA(entity.POAStateCreated), nil)
+    			c.Convey("It should deny access", func(c C) {
+					err := svc.SendAttorney(request.ID, request.Comment)
+					So(err, ShouldHaveSameTypeAs, &AccessDeniedError{})
+				})
+			})
+			c.Convey("When invoking method SendAttorney for expired POA in state eReturned", func(c C) {
+				var (
+					request    = &dto.SendAttorneyRequest{ID: "POA1"}
+					stored     = storedPOA(entity.POAStateReturned)
+				)
+				stored.DateTo = "2021-05-31"
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(stored, nil)
+    			c.Convey("It should return expired error", func(c C) {
+					err := svc.SendAttorney(request.ID, request.Comment)
+					So(err, ShouldEqual, ErrPOAExpired)
+					So(string(stored.State), ShouldEqual, entity.POAStateReturned)
+				})
+			})
+		})
+	})
+}
+func TestPOAServiceReturnAttorney(t *testing.T) {
+	Convey("POA ReturnAttorney", t, func(c C) {
+		// prepare dummy service .
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		poaRep := repository.NewMockPOARepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().POARepository().Return(poaRep).AnyTimes()
+
+		svc := NewPOAServiceImpl(
+			logs.DummyLogger(),
+			rep,
+			txClock,
+			txIdentity,
+			txTransaction,
+		)
+
+		c.Convey("Given POAService", func(c C) {
+			c.Convey("When invoking method ReturnAttorney for POA in state eCreated", func(c C) {
+				var (
+					request    = &dto.ReturnAttorneyRequest{ID: "POA1"}
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(storedPOA(entity.POAStateCreated), nil)
+    			c.Convey("It should return error", func(c C) {
+					err := svc.ReturnAttorney(request.ID, request.Comment)
+					So(err, ShouldNotBeNil)
+				})
+			})
+			c.Convey("When invoking method ReturnAttorney for POA in state eSent", func(c C) {
+				var (
+					request    = &dto.ReturnAttorneyRequest{ID: "POA1"}
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(storedPOA(entity.POAStateSent), nil)
+				poaRep.EXPECT().Update(gomock.Any()).DoAndReturn(func(e *entity.POA) error {
+					So(string(e.State), ShouldEqual, entity.POAStateReturned)
+					return nil
+				})
+    			c.Convey("It should save POA in state Returned", func(c C) {
+					err := svc.ReturnAttorney(request.ID, request.Comment)
+					So(err, ShouldBeNil)
+				})
+			})
+			c.Convey("When invoking method ReturnAttorney for partly approved POA", func(c C) {
+				var (
+					request    = &dto.ReturnAttorneyRequest{ID: "POA1"}
+					stored     = storedPOA(entity.POAStateSent)
+					org3       = NewPOAServiceImpl(logs.DummyLogger(), rep, txClock, confirmerIdentity("Org3MSP"), txTransaction)
+				)
+				stored.ConfirmationPolicy = &entity.ConfirmationPolicy{Required: 2, MSPIDs: []string{"Org1MSP", "Org2MSP", "Org3MSP"}}
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(stored, nil).AnyTimes()
+				poaRep.EXPECT().Update(stored).Return(nil).AnyTimes()
+				So(svc.ConfirmAttorney(request.ID, ""), ShouldBeNil)
+				So(stored.Approvals, ShouldHaveLength, 1)
+
+    			c.Convey("It should discard approvals of the previous round", func(c C) {
+					So(svc.ReturnAttorney(request.ID, request.Comment), ShouldBeNil)
+					So(stored.Approvals, ShouldBeEmpty)
+
+					So(svc.SendAttorney(request.ID, ""), ShouldBeNil)
+					So(org3.ConfirmAttorney(request.ID, ""), ShouldBeNil)
+					So(stored.Approvals, ShouldHaveLength, 1)
+					So(stored.Approvals[0].MSPID, ShouldEqual, "Org3MSP")
+					So(string(stored.State), ShouldEqual, entity.POAStateSent)
+
+					So(svc.ConfirmAttorney(request.ID, ""), ShouldBeNil)
+					So(string(stored.State), ShouldEqual, entity.POAStateConfirmed)
+				})
+			})
+			c.Convey("When invoking method ReturnAttorney by client without confirmer role", func(c C) {
+				var (
+					request    = &dto.ReturnAttorneyRequest{ID: "POA1"}
+					svc        = NewPOAServiceImpl(logs.DummyLogger(), rep, txClock, principalIdentity("7707083893"), txTransaction)
+				)
+    			c.Convey("It should deny access", func(c C) {
+					err := svc.ReturnAttorney(request.ID, request.Comment)
+					So(err, ShouldHaveSameTypeAs, &AccessDeniedError{})
+				})
+			})
+		})
+	})
+}
+func TestPOAServiceRejectAttorney(t *testing.T) {
+	Convey("POA RejectAttorney", t, func(c C) {
+		// prepare dummy service .
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		poaRep := repository.NewMockPOARepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().POARepository().Return(poaRep).AnyTimes()
+
+		svc := NewPOAServiceImpl(
+			logs.DummyLogger(),
+			rep,
+			txClock,
+			txIdentity,
+			txTransaction,
+		)
+
+		c.Convey("Given POAService", func(c C) {
+			c.Convey("When invoking method RejectAttorney for POA in state eReturned", func(c C) {
+				var (
+					request    = &dto.RejectAttorneyRequest{ID: "POA1"}
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(storedPOA(entity.POAStateReturned), nil)
+    			c.Convey("It should return error", func(c C) {
+					err := svc.RejectAttorney(request.ID, request.Comment)
+					So(err, ShouldNotBeNil)
+				})
+			})
+			c.Convey("When invoking method RejectAttorney for POA in state eSent", func(c C) {
+				var (
+					request    = &dto.RejectAttorneyRequest{ID: "POA1"}
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(storedPOA(entity.POAStateSent), nil)
+				poaRep.EXPECT().Update(gomock.Any()).DoAndReturn(func(e *entity.POA) error {
+					So(string(e.State), ShouldEqual, entity.POAStateRejected)
+					return nil
+				})
+    			c.Convey("It should save POA in state Rejected", func(c C) {
+					err := svc.RejectAttorney(request.ID, request.Comment)
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
+func TestPOAServiceRevokeAttorney(t *testing.T) {
+	Convey("POA RevokeAttorney", t, func(c C) {
+		// prepare dummy service .
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		poaRep := repository.NewMockPOARepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().POARepository().Return(poaRep).AnyTimes()
+
+		svc := NewPOAServiceImpl(
+			logs.DummyLogger(),
+			rep,
+			txClock,
+			txIdentity,
+			txTransaction,
+		)
+
+		c.Convey("Given POAService", func(c C) {
+			c.Convey("When invoking method RevokeAttorney without reason", func(c C) {
+				var (
+					request    = &dto.RevokeAttorneyRequest{ID: "POA1"}
+				)
+    			c.Convey("It should return validation error", func(c C) {
+					err := svc.RevokeAttorney(request.ID, request.Reason)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "reason")
+				})
+			})
+			c.Convey("When invoking method RevokeAttorney for POA in state Sent", func(c C) {
+				var (
+					request    = &dto.RevokeAttorneyRequest{ID: "POA1", Reason: "отмена"}
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(storedPOA(entity.POAStateSent), nil)
     			c.Convey("It should return error", func(c C) {
-					err := svc.ConfirmAttorney(request.ID)
+					err := svc.RevokeAttorney(request.ID, request.Reason)
 					So(err, ShouldNotBeNil)
 				})
 			})
+			c.Convey("When invoking method RevokeAttorney for expired POA", func(c C) {
+				var (
+					request    = &dto.RevokeAttorneyRequest{ID: "POA1", Reason: "отмена"}
+					stored     = storedPOA(entity.POAStateConfirmed)
+				)
+				stored.DateTo = "2021-05-31"
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(stored, nil)
+    			c.Convey("It should return expired error", func(c C) {
+					err := svc.RevokeAttorney(request.ID, request.Reason)
+					So(err, ShouldEqual, ErrPOAExpired)
+				})
+			})
+			c.Convey("When invoking method RevokeAttorney by client of another organization", func(c C) {
+				var (
+					request    = &dto.RevokeAttorneyRequest{ID: "POA1", Reason: "отмена"}
+					identity   = principalIdentity("7707083893")
+				)
+				identity.mspID = "Org2MSP"
+				svc := NewPOAServiceImpl(logs.DummyLogger(), rep, txClock, identity, txTransaction)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(storedPOA(entity.POAStateConfirmed), nil)
+    			c.Convey("It should deny access", func(c C) {
+					err := svc.RevokeAttorney(request.ID, request.Reason)
+					So(err, ShouldHaveSameTypeAs, &AccessDeniedError{})
+				})
+			})
+			c.Convey("When invoking method RevokeAttorney for confirmed POA", func(c C) {
+				var (
+					request    = &dto.RevokeAttorneyRequest{ID: "POA1", Reason: "отмена"}
+					stored     = storedPOA(entity.POAStateConfirmed)
+				)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(stored, nil)
+				poaRep.EXPECT().Update(stored).Return(nil)
+    			c.Convey("It should save revoked POA with revocation details", func(c C) {
+					err := svc.RevokeAttorney(request.ID, request.Reason)
+					So(err, ShouldBeNil)
+					So(string(stored.State), ShouldEqual, entity.POAStateRevoked)
+					So(stored.Revocation, ShouldResemble, &entity.Revocation{
+						Reason:       "отмена",
+						RevokerMSPID: "Org1MSP",
+						RevokerID:    "x509::CN=user1::CN=ca",
+						Timestamp:    "2021-06-01T12:00:00Z",
+					})
+					inForce, err := stored.InForce(time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC))
+					So(err, ShouldBeNil)
+					So(inForce, ShouldBeFalse)
+				})
+			})
+		})
+	})
+}
+func TestPOAServiceDelegationChain(t *testing.T) {
+	Convey("POA DelegationChain", t, func(c C) {
+		// prepare dummy service .
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		poaRep := repository.NewMockPOARepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().POARepository().Return(poaRep).AnyTimes()
+
+		svc := NewPOAServiceImpl(
+			logs.DummyLogger(),
+			rep,
+			txClock,
+			txIdentity,
+			txTransaction,
+		)
+
+		c.Convey("Given POAService", func(c C) {
+			c.Convey("When invoking method DelegationChain for POA issued in substitution", func(c C) {
+				var (
+					request    = &dto.DelegationChainRequest{ID: "POA2"}
+					child      = substitutionPOA()
+					parent     = substitutedPOA()
+				)
+				child.BlockchainID = "POA2"
+				poaRep.EXPECT().GetByBlockchainID("POA2").Return(child, nil)
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(parent, nil)
+    			c.Convey("It should return POA and its parent", func(c C) {
+					chain, err := svc.DelegationChain(request.ID)
+					So(err, ShouldBeNil)
+					So(chain, ShouldResemble, []entity.POA{*child, *parent})
+				})
+			})
+			c.Convey("When invoking method DelegationChain for cyclic chain", func(c C) {
+				var (
+					request    = &dto.DelegationChainRequest{ID: "POA1"}
+					cyclic     = substitutedPOA()
+				)
+				cyclic.ParentID = "POA1"
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(cyclic, nil).AnyTimes()
+    			c.Convey("It should return error", func(c C) {
+					_, err := svc.DelegationChain(request.ID)
+					So(err, ShouldEqual, ErrDelegationChainTooDeep)
+				})
+			})
+		})
+	})
+}
+func TestPOAServiceVerifyAuthority(t *testing.T) {
+	Convey("POA VerifyAuthority", t, func(c C) {
+		// prepare dummy service .
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		poaRep := repository.NewMockPOARepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().POARepository().Return(poaRep).AnyTimes()
+
+		svc := NewPOAServiceImpl(
+			logs.DummyLogger(),
+			rep,
+			txClock,
+			txIdentity,
+			txTransaction,
+		)
+
+		anchors := testAnchors
+		anchorRep := repository.NewMockTrustAnchorRepository(ctrl)
+		rep.EXPECT().TrustAnchorRepository().Return(anchorRep).AnyTimes()
+		anchorRep.EXPECT().List().DoAndReturn(func() ([]entity.TrustAnchor, error) {
+			return anchors, nil
+		}).AnyTimes()
+
+		var (
+			parent = signed(substitutedPOA())
+			child  = signed(substitutionPOA())
+		)
+		child.BlockchainID = "POA2"
+		child.State = entity.POAStateConfirmed
+		poaRep.EXPECT().GetByBlockchainID("POA1").Return(parent, nil).AnyTimes()
+		poaRep.EXPECT().GetByBlockchainID("POA2").Return(child, nil).AnyTimes()
+
+		c.Convey("Given POAService", func(c C) {
+			c.Convey("When invoking method VerifyAuthority without power code", func(c C) {
+				var (
+					request    = &dto.VerifyAuthorityRequest{RepresentativeINN: "500100732259", PrincipalINN: "7707083893"}
+				)
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.VerifyAuthority(request.RepresentativeINN, request.PrincipalINN, request.PowerCode, request.Timestamp)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+				})
+			})
+			c.Convey("When invoking method VerifyAuthority for representative of confirmed POA", func(c C) {
+				var (
+					request    = &dto.VerifyAuthorityRequest{RepresentativeINN: "500100732259", PrincipalINN: "7707083893", PowerCode: "PAY"}
+				)
+				poaRep.EXPECT().Find(gomock.Any()).Return(&entity.POAPage{POAs: []entity.POA{*parent}}, nil)
+    			c.Convey("It should authorize the representative by the POA", func(c C) {
+					verdict, err := svc.VerifyAuthority(request.RepresentativeINN, request.PrincipalINN, request.PowerCode, request.Timestamp)
+					So(err, ShouldBeNil)
+					So(verdict.Authorized, ShouldBeTrue)
+					So(verdict.At, ShouldEqual, "2021-06-01T12:00:00Z")
+					So(verdict.POAs, ShouldResemble, []entity.POA{*parent})
+				})
+				c.Convey("It should not authorize the representative after the POA is revoked", func(c C) {
+					parent.State = entity.POAStateRevoked
+					verdict, err := svc.VerifyAuthority(request.RepresentativeINN, request.PrincipalINN, request.PowerCode, request.Timestamp)
+					So(err, ShouldBeNil)
+					So(verdict.Authorized, ShouldBeFalse)
+					So(verdict.Reason, ShouldNotBeEmpty)
+				})
+				c.Convey("It should not authorize the representative by POA changed after signing", func(c C) {
+					parent.DateTo = "2021-12-30"
+					verdict, err := svc.VerifyAuthority(request.RepresentativeINN, request.PrincipalINN, request.PowerCode, request.Timestamp)
+					So(err, ShouldBeNil)
+					So(verdict.Authorized, ShouldBeFalse)
+					So(verdict.Reason, ShouldContainSubstring, "signature")
+				})
+				c.Convey("It should not authorize the representative after the trust anchor is removed", func(c C) {
+					anchors = nil
+					verdict, err := svc.VerifyAuthority(request.RepresentativeINN, request.PrincipalINN, request.PowerCode, request.Timestamp)
+					So(err, ShouldBeNil)
+					So(verdict.Authorized, ShouldBeFalse)
+				})
+				c.Convey("It should not authorize the representative for power which is not granted", func(c C) {
+					verdict, err := svc.VerifyAuthority(request.RepresentativeINN, request.PrincipalINN, "SELL", request.Timestamp)
+					So(err, ShouldBeNil)
+					So(verdict.Authorized, ShouldBeFalse)
+				})
+			})
+			c.Convey("When invoking method VerifyAuthority for representative of substitution POA", func(c C) {
+				var (
+					request    = &dto.VerifyAuthorityRequest{RepresentativeINN: "771234567859", PrincipalINN: "7707083893", PowerCode: "SIGN"}
+				)
+				poaRep.EXPECT().Find(gomock.Any()).Return(&entity.POAPage{POAs: []entity.POA{*child}}, nil)
+    			c.Convey("It should authorize the representative by the delegation chain", func(c C) {
+					verdict, err := svc.VerifyAuthority(request.RepresentativeINN, request.PrincipalINN, request.PowerCode, request.Timestamp)
+					So(err, ShouldBeNil)
+					So(verdict.Authorized, ShouldBeTrue)
+					So(verdict.POAs, ShouldResemble, []entity.POA{*child, *parent})
+				})
+				c.Convey("It should not authorize the representative after the parent POA expired", func(c C) {
+					parent.DateTo = "2021-05-31"
+					verdict, err := svc.VerifyAuthority(request.RepresentativeINN, request.PrincipalINN, request.PowerCode, request.Timestamp)
+					So(err, ShouldBeNil)
+					So(verdict.Authorized, ShouldBeFalse)
+				})
+			})
+			c.Convey("When invoking method VerifyAuthority at the moment in the past", func(c C) {
+				var (
+					request    = &dto.VerifyAuthorityRequest{RepresentativeINN: "500100732259", PrincipalINN: "7707083893", PowerCode: "PAY", Timestamp: "2021-03-01T00:00:00Z"}
+					at         = time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+					revoked    = signed(substitutedPOA())
+				)
+				revoked.State = entity.POAStateRevoked
+				poaRep.EXPECT().Find(gomock.Any()).Return(&entity.POAPage{POAs: []entity.POA{*revoked}}, nil)
+				poaRep.EXPECT().GetByBlockchainIDAt("POA1", at).Return(parent, nil)
+    			c.Convey("It should use the POA version committed at the moment", func(c C) {
+					verdict, err := svc.VerifyAuthority(request.RepresentativeINN, request.PrincipalINN, request.PowerCode, request.Timestamp)
+					So(err, ShouldBeNil)
+					So(verdict.Authorized, ShouldBeTrue)
+					So(verdict.At, ShouldEqual, "2021-03-01T00:00:00Z")
+				})
+			})
+		})
+	})
+}
+func TestPOAServiceGetApprovals(t *testing.T) {
+	Convey("POA GetApprovals", t, func(c C) {
+		// prepare dummy service .
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		poaRep := repository.NewMockPOARepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().POARepository().Return(poaRep).AnyTimes()
+
+		svc := NewPOAServiceImpl(
+			logs.DummyLogger(),
+			rep,
+			txClock,
+			txIdentity,
+			txTransaction,
+		)
+
+		c.Convey("Given POAService", func(c C) {
+			c.Convey("When invoking method GetApprovals with empty id", func(c C) {
+				var (
+					request    = &dto.GetApprovalsRequest{}
+				)
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.GetApprovals(request.ID)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+				})
+			})
+			c.Convey("When invoking method GetApprovals for POA approved by one organization", func(c C) {
+				var (
+					request    = &dto.GetApprovalsRequest{ID: "POA1"}
+					stored     = storedPOA(entity.POAStateSent)
+				)
+				stored.ConfirmationPolicy = &entity.ConfirmationPolicy{Required: 2, MSPIDs: []string{"Org1MSP", "Org2MSP"}}
+				stored.Approvals = []entity.Approval{{MSPID: "Org2MSP", ApproverID: "x509::CN=user3::CN=ca", Timestamp: "2021-05-31T10:00:00Z"}}
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(stored, nil)
+    			c.Convey("It should return collected approvals", func(c C) {
+					approvals, err := svc.GetApprovals(request.ID)
+					So(err, ShouldBeNil)
+					So(approvals, ShouldResemble, stored.Approvals)
+				})
+			})
 		})
 	})
 }
+func TestPOAServiceGetAuditTrail(t *testing.T) {
+	Convey("POA GetAuditTrail", t, func(c C) {
+		// prepare dummy service .
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
+		poaRep := repository.NewMockPOARepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().POARepository().Return(poaRep).AnyTimes()
+
+		svc := NewPOAServiceImpl(
+			logs.DummyLogger(),
+			rep,
+			txClock,
+			txIdentity,
+			txTransaction,
+		)
+
+		c.Convey("Given POAService", func(c C) {
+			c.Convey("When invoking method GetAuditTrail with empty id", func(c C) {
+				var (
+					request    = &dto.GetAuditTrailRequest{}
+				)
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.GetAuditTrail(request.ID)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+				})
+			})
+			c.Convey("When invoking method GetAuditTrail for sent POA", func(c C) {
+				var (
+					request    = &dto.GetAuditTrailRequest{ID: "POA1"}
+					stored     = storedPOA(entity.POAStateSent)
+				)
+				stored.Audit = []entity.AuditRecord{
+					{Transition: "Create", To: entity.POAStateCreated, MSPID: "Org1MSP", TxID: "tx0"},
+					{Transition: "SendAttorney", From: entity.POAStateCreated, To: entity.POAStateSent, MSPID: "Org1MSP", TxID: "tx1"},
+				}
+				poaRep.EXPECT().GetByBlockchainID("POA1").Return(stored, nil)
+    			c.Convey("It should return records in order", func(c C) {
+					trail, err := svc.GetAuditTrail(request.ID)
+					So(err, ShouldBeNil)
+					So(trail, ShouldResemble, stored.Audit)
+				})
+			})
+		})
+	})
+}
+func TestPOAServiceFindAttorneys(t *testing.T) {
+	Convey("POA FindAttorneys", t, func(c C) {
+		// prepare dummy service .
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		poaRep := repository.NewMockPOARepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().POARepository().Return(poaRep).AnyTimes()
+
+		svc := NewPOAServiceImpl(
+			logs.DummyLogger(),
+			rep,
+			txClock,
+			txIdentity,
+			txTransaction,
+		)
+
+		c.Convey("Given POAService", func(c C) {
+			c.Convey("When invoking method FindAttorneys with too large page", func(c C) {
+				var (
+					request    = &dto.FindAttorneysRequest{Request: &entity.POASearchRequest{PageSize: MaxPageSize + 1}}
+				)
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.FindAttorneys(request.Request)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+				})
+			})
+			c.Convey("When invoking method FindAttorneys without page size", func(c C) {
+				var (
+					inn        = "7707083893"
+					request    = &dto.FindAttorneysRequest{Request: &entity.POASearchRequest{PrincipalINN: &inn, Bookmark: "b1"}}
+					page       = &entity.POAPage{POAs: []entity.POA{*storedPOA(entity.POAStateSent)}, Bookmark: "b2"}
+				)
+				poaRep.EXPECT().Find(gomock.Any()).DoAndReturn(func(req *entity.POASearchRequest) (*entity.POAPage, error) {
+					So(req.PageSize, ShouldEqual, DefaultPageSize)
+					So(req.Bookmark, ShouldEqual, "b1")
+					So(*req.PrincipalINN, ShouldEqual, inn)
+					return page, nil
+				})
+    			c.Convey("It should return the page of default size", func(c C) {
+					result, err := svc.FindAttorneys(request.Request)
+					So(err, ShouldBeNil)
+					So(result, ShouldEqual, page)
+					So(request.Request.PageSize, ShouldEqual, 0)
+				})
+			})
+			c.Convey("When invoking method FindAttorneys with inverted date range", func(c C) {
+				var (
+					request    = &dto.FindAttorneysRequest{Request: &entity.POASearchRequest{DateToRange: &entity.DateRange{From: "2026-02-01", To: "2026-01-01"}}}
+				)
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.FindAttorneys(request.Request)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "date_to_range")
+				})
+			})
+			c.Convey("When invoking method FindAttorneys with malformed valid at", func(c C) {
+				var (
+					validAt    = "yesterday"
+					request    = &dto.FindAttorneysRequest{Request: &entity.POASearchRequest{ValidAt: &validAt}}
+				)
+    			c.Convey("It should return validation error", func(c C) {
+					_, err := svc.FindAttorneys(request.Request)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+					So(err.(*ValidationError).Field, ShouldEqual, "valid_at")
+				})
+			})
+			c.Convey("When invoking method FindAttorneys with date range and valid at", func(c C) {
+				var (
+					validAt    = "2026-06-01T12:00:00Z"
+					request    = &dto.FindAttorneysRequest{Request: &entity.POASearchRequest{
+						DateFromRange: &entity.DateRange{From: "2026-01-01", To: "2026-03-31"},
+						ValidAt:       &validAt,
+					}}
+					page       = &entity.POAPage{}
+				)
+				poaRep.EXPECT().Find(gomock.Any()).DoAndReturn(func(req *entity.POASearchRequest) (*entity.POAPage, error) {
+					So(*req.DateFromRange, ShouldResemble, entity.DateRange{From: "2026-01-01", To: "2026-03-31"})
+					So(*req.ValidAt, ShouldEqual, validAt)
+					return page, nil
+				})
+    			c.Convey("It should pass criteria to the repository", func(c C) {
+					result, err := svc.FindAttorneys(request.Request)
+					So(err, ShouldBeNil)
+					So(result, ShouldEqual, page)
+				})
+			})
+		})
+	})
+}
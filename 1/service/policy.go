@@ -0,0 +1,194 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/procsy-tech/attorney/api"
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/repository"
+	"github.com/procsy-tech/attorney/txcontext"
+	"github.com/procsy-tech/attorney/utils/logs"
+)
+
+func NewPolicyServiceImpl(
+	log logs.Logger,
+	rep repository.Repository,
+	identity txcontext.Identity,
+) PolicyService {
+	return &PolicyServiceImpl{
+		log,
+		rep,
+		identity,
+	}
+}
+
+type PolicyServiceImpl struct {
+	log      logs.Logger
+	rep      repository.Repository
+	identity txcontext.Identity
+}
+
+// table returns the policy table from the ledger, an empty table when it is not set yet.
+func (svc *PolicyServiceImpl) table() (*entity.PolicyTable, error) {
+	table, err := svc.rep.PolicyRepository().Get()
+	if err == repository.ErrPolicyNotFound {
+		return &entity.PolicyTable{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policy table: %s", err)
+	}
+	return table, nil
+}
+
+// Authorize checks the client identity against the rule of the route.
+func (svc *PolicyServiceImpl) Authorize(Route string) error {
+	table, err := svc.table()
+	if err != nil {
+		return err
+	}
+
+	rule, found := table.Rule(Route)
+	if !found {
+		if isGovernanceRoute(Route) {
+			return &AccessDeniedError{Operation: Route, Reason: "route is not configured in policy table"}
+		}
+		return nil
+	}
+
+	return svc.check(Route, rule)
+}
+
+func (svc *PolicyServiceImpl) check(route string, rule *entity.PolicyRule) error {
+	if len(rule.MSPIDs) != 0 {
+		mspID, err := svc.identity.MSPID()
+		if err != nil {
+			return fmt.Errorf("failed to get client MSP ID: %s", err)
+		}
+		if !contains(rule.MSPIDs, mspID) {
+			return &AccessDeniedError{Operation: route, Reason: fmt.Sprintf("MSP %s is not allowed", mspID)}
+		}
+	}
+
+	names := make([]string, 0, len(rule.Attributes))
+	for name := range rule.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		expected := rule.Attributes[name]
+		value, found, err := svc.identity.Attribute(name)
+		if err != nil {
+			return fmt.Errorf("failed to get client attribute %s: %s", name, err)
+		}
+		if !found || value != expected {
+			return &AccessDeniedError{Operation: route, Reason: fmt.Sprintf("attribute %s does not match", name)}
+		}
+	}
+
+	if len(rule.Roles) != 0 {
+		for _, role := range rule.Roles {
+			ok, err := hasRole(svc.identity, role)
+			if err != nil {
+				return err
+			}
+			if ok {
+				return nil
+			}
+		}
+		return &AccessDeniedError{Operation: route, Reason: "client has none of required roles"}
+	}
+
+	return nil
+}
+
+// GetPolicy returns the policy table.
+func (svc *PolicyServiceImpl) GetPolicy() (*entity.PolicyTable, error) {
+	return svc.table()
+}
+
+// UpdatePolicy replaces the policy table. The table must restrict governance routes,
+// otherwise nobody could change it later.
+func (svc *PolicyServiceImpl) UpdatePolicy(Policy *entity.PolicyTable) error {
+	if Policy == nil {
+		return &ValidationError{Field: "policy", Reason: "required"}
+	}
+	for _, route := range api.GovernanceRoutes {
+		rule, found := Policy.Rule(route)
+		if !found || rule.IsOpen() {
+			return &ValidationError{Field: "routes", Reason: fmt.Sprintf("rule restricting %s is required", route)}
+		}
+	}
+
+	err := svc.rep.PolicyRepository().Put(Policy)
+	if err != nil {
+		return fmt.Errorf("failed to save policy table: %s", err)
+	}
+
+	return nil
+}
+
+// settings returns the chaincode settings, the defaults when they are not set yet.
+func (svc *PolicyServiceImpl) settings() (*entity.Settings, error) {
+	settings, err := svc.rep.SettingsRepository().Get()
+	if err == repository.ErrSettingsNotFound {
+		return &entity.Settings{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings: %s", err)
+	}
+	return settings, nil
+}
+
+// EnableDebug turns the _debug route on or off. It is called from Init only.
+func (svc *PolicyServiceImpl) EnableDebug(Enabled bool) error {
+	settings, err := svc.settings()
+	if err != nil {
+		return err
+	}
+	if settings.Debug == Enabled {
+		return nil
+	}
+
+	settings.Debug = Enabled
+	err = svc.rep.SettingsRepository().Put(settings)
+	if err != nil {
+		return fmt.Errorf("failed to save settings: %s", err)
+	}
+
+	return nil
+}
+
+// AuthorizeDebug allows the _debug route to admin clients, provided it is enabled by Init config.
+func (svc *PolicyServiceImpl) AuthorizeDebug() error {
+	settings, err := svc.settings()
+	if err != nil {
+		return err
+	}
+	if !settings.Debug {
+		return &AccessDeniedError{Operation: "_debug", Reason: "debug is disabled"}
+	}
+
+	ok, err := hasRole(svc.identity, RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &AccessDeniedError{Operation: "_debug", Reason: fmt.Sprintf("client has no %s role", RoleAdmin)}
+	}
+
+	return nil
+}
+
+func isGovernanceRoute(route string) bool {
+	return contains(api.GovernanceRoutes, route)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
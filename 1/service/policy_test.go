@@ -0,0 +1,179 @@
+package service
+
+import (
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/kbkontrakt/hlfabric-ccdevkit/logs"
+	"github.com/procsy-tech/attorney/api"
+	"github.com/procsy-tech/attorney/dto"
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/repository"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// governancePolicy returns policy table letting Org1MSP admins govern, admins maintain the powers catalog
+// and confirmers confirm.
+func governancePolicy() *entity.PolicyTable {
+	return &entity.PolicyTable{
+		Routes: map[string]entity.PolicyRule{
+			api.UpdatePolicy:      {MSPIDs: []string{"Org1MSP"}, Attributes: map[string]string{"hf.Type": "admin"}},
+			api.AddTrustAnchor:    {MSPIDs: []string{"Org1MSP"}, Attributes: map[string]string{"hf.Type": "admin"}},
+			api.DeleteTrustAnchor: {MSPIDs: []string{"Org1MSP"}, Attributes: map[string]string{"hf.Type": "admin"}},
+			api.CreatePower:       {Roles: []string{RoleAdmin}},
+			api.UpdatePower:       {Roles: []string{RoleAdmin}},
+			api.DeletePower:       {Roles: []string{RoleAdmin}},
+			api.ConfirmAttorney:   {MSPIDs: []string{"Org1MSP", "Org2MSP"}, Roles: []string{RoleConfirmer}},
+		},
+	}
+}
+
+func TestPolicyServiceAuthorize(t *testing.T) {
+	Convey("Policy Authorize", t, func(c C) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		policyRep := repository.NewMockPolicyRepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().PolicyRepository().Return(policyRep).AnyTimes()
+
+		svc := NewPolicyServiceImpl(
+			logs.DummyLogger(),
+			rep,
+			txIdentity,
+		)
+
+		c.Convey("Given PolicyService", func(c C) {
+			c.Convey("When policy table is not set", func(c C) {
+				policyRep.EXPECT().Get().Return(nil, repository.ErrPolicyNotFound).AnyTimes()
+				c.Convey("It should allow ordinary routes", func(c C) {
+					So(svc.Authorize(api.Create), ShouldBeNil)
+				})
+				c.Convey("It should deny governance routes", func(c C) {
+					So(svc.Authorize(api.UpdatePolicy), ShouldHaveSameTypeAs, &AccessDeniedError{})
+				})
+				c.Convey("It should deny changes of the powers catalog", func(c C) {
+					So(svc.Authorize(api.CreatePower), ShouldHaveSameTypeAs, &AccessDeniedError{})
+					So(svc.Authorize(api.UpdatePower), ShouldHaveSameTypeAs, &AccessDeniedError{})
+					So(svc.Authorize(api.DeletePower), ShouldHaveSameTypeAs, &AccessDeniedError{})
+					So(svc.Authorize(api.ListPowers), ShouldBeNil)
+				})
+			})
+			c.Convey("When policy table is set", func(c C) {
+				table := governancePolicy()
+				policyRep.EXPECT().Get().Return(table, nil).AnyTimes()
+				c.Convey("It should allow client satisfying the rule", func(c C) {
+					So(svc.Authorize(api.ConfirmAttorney), ShouldBeNil)
+				})
+				c.Convey("It should deny client without required attribute", func(c C) {
+					So(svc.Authorize(api.UpdatePolicy), ShouldHaveSameTypeAs, &AccessDeniedError{})
+				})
+				c.Convey("It should deny changes of the powers catalog to client without admin role", func(c C) {
+					So(svc.Authorize(api.CreatePower), ShouldHaveSameTypeAs, &AccessDeniedError{})
+					So(svc.Authorize(api.UpdatePower), ShouldHaveSameTypeAs, &AccessDeniedError{})
+					So(svc.Authorize(api.DeletePower), ShouldHaveSameTypeAs, &AccessDeniedError{})
+				})
+				c.Convey("It should allow changes of the powers catalog to admin", func(c C) {
+					identity := principalIdentity("7707083893")
+					identity.ous = []string{RoleAdmin}
+					svc := NewPolicyServiceImpl(logs.DummyLogger(), rep, identity)
+					So(svc.Authorize(api.CreatePower), ShouldBeNil)
+					So(svc.Authorize(api.DeletePower), ShouldBeNil)
+				})
+				c.Convey("It should deny client of other MSP", func(c C) {
+					identity := principalIdentity("7707083893")
+					identity.mspID = "Org3MSP"
+					identity.ous = []string{RoleConfirmer}
+					svc := NewPolicyServiceImpl(logs.DummyLogger(), rep, identity)
+					So(svc.Authorize(api.ConfirmAttorney), ShouldHaveSameTypeAs, &AccessDeniedError{})
+				})
+				c.Convey("It should apply default rule to unlisted routes", func(c C) {
+					So(svc.Authorize(api.Create), ShouldBeNil)
+					table.Default = &entity.PolicyRule{MSPIDs: []string{"Org2MSP"}}
+					So(svc.Authorize(api.Create), ShouldHaveSameTypeAs, &AccessDeniedError{})
+				})
+			})
+		})
+	})
+}
+
+func TestPolicyServiceUpdatePolicy(t *testing.T) {
+	Convey("Policy UpdatePolicy", t, func(c C) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		policyRep := repository.NewMockPolicyRepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().PolicyRepository().Return(policyRep).AnyTimes()
+
+		svc := NewPolicyServiceImpl(
+			logs.DummyLogger(),
+			rep,
+			txIdentity,
+		)
+
+		c.Convey("Given PolicyService", func(c C) {
+			c.Convey("When invoking method UpdatePolicy leaving governance route open", func(c C) {
+				request := &dto.UpdatePolicyRequest{Policy: &entity.PolicyTable{
+					Routes: map[string]entity.PolicyRule{api.UpdatePolicy: {}},
+				}}
+				c.Convey("It should return validation error", func(c C) {
+					err := svc.UpdatePolicy(request.Policy)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+				})
+			})
+			c.Convey("When invoking method UpdatePolicy with governance rule", func(c C) {
+				request := &dto.UpdatePolicyRequest{Policy: governancePolicy()}
+				policyRep.EXPECT().Put(request.Policy).Return(nil)
+				c.Convey("It should save it", func(c C) {
+					err := svc.UpdatePolicy(request.Policy)
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
+
+func TestPolicyServiceAuthorizeDebug(t *testing.T) {
+	Convey("Policy AuthorizeDebug", t, func(c C) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		settingsRep := repository.NewMockSettingsRepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().SettingsRepository().Return(settingsRep).AnyTimes()
+
+		admin := principalIdentity("7707083893")
+		admin.ous = []string{RoleAdmin}
+
+		c.Convey("Given PolicyService", func(c C) {
+			c.Convey("When settings are not set", func(c C) {
+				settingsRep.EXPECT().Get().Return(nil, repository.ErrSettingsNotFound).AnyTimes()
+				c.Convey("It should deny admin", func(c C) {
+					svc := NewPolicyServiceImpl(logs.DummyLogger(), rep, admin)
+					So(svc.AuthorizeDebug(), ShouldHaveSameTypeAs, &AccessDeniedError{})
+				})
+				c.Convey("It should save settings enabling debug", func(c C) {
+					settingsRep.EXPECT().Put(&entity.Settings{Debug: true}).Return(nil)
+					svc := NewPolicyServiceImpl(logs.DummyLogger(), rep, admin)
+					So(svc.EnableDebug(true), ShouldBeNil)
+				})
+				c.Convey("It should not save settings disabling debug", func(c C) {
+					svc := NewPolicyServiceImpl(logs.DummyLogger(), rep, admin)
+					So(svc.EnableDebug(false), ShouldBeNil)
+				})
+			})
+			c.Convey("When debug is enabled", func(c C) {
+				settingsRep.EXPECT().Get().Return(&entity.Settings{Debug: true}, nil).AnyTimes()
+				c.Convey("It should allow admin", func(c C) {
+					svc := NewPolicyServiceImpl(logs.DummyLogger(), rep, admin)
+					So(svc.AuthorizeDebug(), ShouldBeNil)
+				})
+				c.Convey("It should deny client without admin role", func(c C) {
+					svc := NewPolicyServiceImpl(logs.DummyLogger(), rep, txIdentity)
+					So(svc.AuthorizeDebug(), ShouldHaveSameTypeAs, &AccessDeniedError{})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/repository"
+	"github.com/procsy-tech/attorney/utils/logs"
+)
+
+func NewPowerServiceImpl(
+	log logs.Logger,
+	rep repository.Repository,
+) PowerService {
+	return &PowerServiceImpl{
+		log,
+		rep,
+	}
+}
+
+type PowerServiceImpl struct {
+	log logs.Logger
+	rep repository.Repository
+}
+
+// validatePower checks powers catalog entry.
+func (svc *PowerServiceImpl) validatePower(Power *entity.PowerDefinition) error {
+	if Power == nil {
+		return &ValidationError{Field: "power", Reason: "required"}
+	}
+	if len(Power.Code) == 0 {
+		return &ValidationError{Field: "code", Reason: "required"}
+	}
+	if strings.ContainsAny(Power.Code, " \t\n\x00") {
+		return &ValidationError{Field: "code", Reason: "must not contain whitespaces"}
+	}
+	return nil
+}
+
+// CreatePower adds power to the catalog.
+func (svc *PowerServiceImpl) CreatePower(Power *entity.PowerDefinition) error {
+	err := svc.validatePower(Power)
+	if err != nil {
+		return err
+	}
+
+	err = svc.rep.PowerRepository().New(Power)
+	if err != nil {
+		return fmt.Errorf("failed to save power: %s", err)
+	}
+
+	return nil
+}
+
+// UpdatePower changes description of cataloged power.
+func (svc *PowerServiceImpl) UpdatePower(Power *entity.PowerDefinition) error {
+	err := svc.validatePower(Power)
+	if err != nil {
+		return err
+	}
+
+	err = svc.rep.PowerRepository().Update(Power)
+	if err != nil {
+		return fmt.Errorf("failed to save power: %s", err)
+	}
+
+	return nil
+}
+
+// DeletePower removes power from the catalog. Issued POAs keep the power.
+func (svc *PowerServiceImpl) DeletePower(Code string) error {
+	if len(Code) == 0 {
+		return &ValidationError{Field: "code", Reason: "required"}
+	}
+
+	return svc.rep.PowerRepository().DeleteByCode(Code)
+}
+
+// GetPower .
+func (svc *PowerServiceImpl) GetPower(Code string) (*entity.PowerDefinition, error) {
+	if len(Code) == 0 {
+		return nil, &ValidationError{Field: "code", Reason: "required"}
+	}
+
+	return svc.rep.PowerRepository().GetByCode(Code)
+}
+
+// ListPowers returns the whole powers catalog.
+func (svc *PowerServiceImpl) ListPowers() ([]entity.PowerDefinition, error) {
+	return svc.rep.PowerRepository().List()
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/kbkontrakt/hlfabric-ccdevkit/logs"
+	"github.com/procsy-tech/attorney/dto"
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/repository"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPowerServiceCreatePower(t *testing.T) {
+	Convey("Power CreatePower", t, func(c C) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		powerRep := repository.NewMockPowerRepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().PowerRepository().Return(powerRep).AnyTimes()
+
+		svc := NewPowerServiceImpl(
+			logs.DummyLogger(),
+			rep,
+		)
+
+		c.Convey("Given PowerService", func(c C) {
+			c.Convey("When invoking method CreatePower without code", func(c C) {
+				request := &dto.CreatePowerRequest{Power: &entity.PowerDefinition{Description: "подписывать документы"}}
+				c.Convey("It should return validation error", func(c C) {
+					err := svc.CreatePower(request.Power)
+					So(err, ShouldHaveSameTypeAs, &ValidationError{})
+				})
+			})
+			c.Convey("When invoking method CreatePower for existing code", func(c C) {
+				request := &dto.CreatePowerRequest{Power: &entity.PowerDefinition{Code: "SIGN"}}
+				powerRep.EXPECT().New(request.Power).Return(repository.ErrPowerExists)
+				c.Convey("It should return error", func(c C) {
+					err := svc.CreatePower(request.Power)
+					So(err, ShouldNotBeNil)
+				})
+			})
+			c.Convey("When invoking method CreatePower for new code", func(c C) {
+				request := &dto.CreatePowerRequest{Power: &entity.PowerDefinition{Code: "SIGN"}}
+				powerRep.EXPECT().New(request.Power).Return(nil)
+				c.Convey("It should save it", func(c C) {
+					err := svc.CreatePower(request.Power)
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
+
+func TestPowerServiceDeletePower(t *testing.T) {
+	Convey("Power DeletePower", t, func(c C) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		powerRep := repository.NewMockPowerRepository(ctrl)
+		rep := repository.NewMockRepository(ctrl)
+		rep.EXPECT().PowerRepository().Return(powerRep).AnyTimes()
+
+		svc := NewPowerServiceImpl(
+			logs.DummyLogger(),
+			rep,
+		)
+
+		c.Convey("Given PowerService", func(c C) {
+			c.Convey("When invoking method DeletePower for unknown code", func(c C) {
+				request := &dto.DeletePowerRequest{Code: "FLY"}
+				powerRep.EXPECT().DeleteByCode("FLY").Return(repository.ErrPowerNotFound)
+				c.Convey("It should return not found error", func(c C) {
+					err := svc.DeletePower(request.Code)
+					So(err, ShouldEqual, repository.ErrPowerNotFound)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"time"
+
+	"github.com/procsy-tech/attorney/entity"
+)
+
+// validateDateRange checks range bounds are calendar dates and the range is not empty.
+func validateDateRange(field string, r *entity.DateRange) error {
+	if r == nil {
+		return nil
+	}
+	var from, to time.Time
+	var err error
+	if len(r.From) != 0 {
+		if from, err = time.Parse(entity.POADateLayout, r.From); err != nil {
+			return &ValidationError{Field: field + ".from", Reason: "must be a date"}
+		}
+	}
+	if len(r.To) != 0 {
+		if to, err = time.Parse(entity.POADateLayout, r.To); err != nil {
+			return &ValidationError{Field: field + ".to", Reason: "must be a date"}
+		}
+	}
+	if len(r.From) != 0 && len(r.To) != 0 && from.After(to) {
+		return &ValidationError{Field: field, Reason: "from is after to"}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/signature"
+)
+
+// trustAnchors returns CA certificates registered in the ledger.
+func (svc *POAServiceImpl) trustAnchors() ([]*x509.Certificate, error) {
+	anchors, err := svc.rep.TrustAnchorRepository().List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trust anchors: %s", err)
+	}
+
+	var certs []*x509.Certificate
+	for inx := range anchors {
+		parsed, err := signature.ParseCertificates(anchors[inx].Certificate)
+		if err != nil {
+			return nil, fmt.Errorf("trust anchor %s: %s", anchors[inx].Fingerprint, err)
+		}
+		certs = append(certs, parsed...)
+	}
+	return certs, nil
+}
+
+// verifySignature checks the principal's detached signature of the POA, given either as a raw value
+// or as a CMS container, and the signer certificate chain at the moment. The signer certificate must
+// carry the principal INN. Returns the signer fingerprint.
+func (svc *POAServiceImpl) verifySignature(POA *entity.POA, at time.Time) (string, error) {
+	if POA.Signature == nil || len(POA.Signature.Value) == 0 && len(POA.Signature.Container) == 0 {
+		return "", &ValidationError{Field: "signature", Reason: "required"}
+	}
+
+	payload, err := POA.SigningPayload()
+	if err != nil {
+		return "", err
+	}
+	anchors, err := svc.trustAnchors()
+	if err != nil {
+		return "", err
+	}
+
+	var signer *x509.Certificate
+	if len(POA.Signature.Container) != 0 {
+		container, err := base64.StdEncoding.DecodeString(POA.Signature.Container)
+		if err != nil {
+			return "", &ValidationError{Field: "signature.container", Reason: "not a base64 string"}
+		}
+		signer, err = signature.VerifyContainer(payload, container, POA.Signature.Certificate, anchors, at)
+		if err != nil {
+			return "", &ValidationError{Field: "signature.container", Reason: err.Error()}
+		}
+	} else {
+		sig, err := base64.StdEncoding.DecodeString(POA.Signature.Value)
+		if err != nil {
+			return "", &ValidationError{Field: "signature.value", Reason: "not a base64 string"}
+		}
+		signer, err = signature.Verify(payload, sig, POA.Signature.Certificate, anchors, at)
+		if err != nil {
+			return "", &ValidationError{Field: "signature", Reason: err.Error()}
+		}
+	}
+
+	if POA.Principal == nil || !contains(signature.SubjectINNs(signer), POA.Principal.INN) {
+		return "", &ValidationError{Field: "signature.certificate", Reason: "signer is not the principal"}
+	}
+
+	fingerprint := signature.Fingerprint(signer)
+	if len(POA.Signature.Fingerprint) != 0 && POA.Signature.Fingerprint != fingerprint {
+		return "", &ValidationError{Field: "signature.fingerprint", Reason: "does not match signer certificate"}
+	}
+
+	return fingerprint, nil
+}
+
+// recheckSignature verifies the stored signature again at the moment it was verified on registration,
+// so that removed trust anchors and tampered documents are detected.
+func (svc *POAServiceImpl) recheckSignature(POA *entity.POA) error {
+	if POA.Signature == nil {
+		return &ValidationError{Field: "signature", Reason: "required"}
+	}
+	at, err := time.Parse(time.RFC3339, POA.Signature.VerifiedAt)
+	if err != nil {
+		return &ValidationError{Field: "signature.verified_at", Reason: err.Error()}
+	}
+	_, err = svc.verifySignature(POA, at)
+	return err
+}
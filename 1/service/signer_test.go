@@ -0,0 +1,215 @@
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/signature"
+	"github.com/procsy-tech/attorney/signature/cms"
+)
+
+// testAuthority is a CA issuing signer certificates valid through 2020-2029.
+type testAuthority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+}
+
+// testSigner holds signer key and PEM certificate.
+type testSigner struct {
+	key *ecdsa.PrivateKey
+	pem string
+}
+
+var (
+	testCA = newTestAuthority()
+
+	// testAnchors are trust anchors kept in the ledger.
+	testAnchors = []entity.TrustAnchor{{Name: "Test CA", Certificate: testCA.pem}}
+
+	// testSigners maps principal INN to its signer.
+	testSigners = map[string]*testSigner{
+		"7707083893":   testCA.issue(signature.OIDINNLE, "7707083893"),
+		"500100732259": testCA.issue(signature.OIDINN, "500100732259"),
+		"771234567859": testCA.issue(signature.OIDINN, "771234567859"),
+	}
+)
+
+func newTestAuthority() *testAuthority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+	return &testAuthority{cert, key, toPEM(der)}
+}
+
+func (ca *testAuthority) issue(oid asn1.ObjectIdentifier, inn string) *testSigner {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject: pkix.Name{
+			CommonName: inn,
+			ExtraNames: []pkix.AttributeTypeAndValue{{Type: oid, Value: inn}},
+		},
+		NotBefore: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:  time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		KeyUsage:  x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		panic(err)
+	}
+	return &testSigner{key, toPEM(der)}
+}
+
+func toPEM(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// signed signs POA by its principal as registered at validPOA transaction time.
+func signed(e *entity.POA) *entity.POA {
+	return signedBy(e, e.Principal.INN)
+}
+
+// signedBy signs POA by the holder of INN.
+func signedBy(e *entity.POA, inn string) *entity.POA {
+	signer := testSigners[inn]
+	payload, err := e.SigningPayload()
+	if err != nil {
+		panic(err)
+	}
+	digest := sha256.Sum256(payload)
+	sig, err := signer.key.Sign(rand.Reader, digest[:], nil)
+	if err != nil {
+		panic(err)
+	}
+	certs, _ := signature.ParseCertificates(signer.pem)
+	e.Signature = &entity.Signature{
+		Value:       base64.StdEncoding.EncodeToString(sig),
+		Certificate: signer.pem,
+		Fingerprint: signature.Fingerprint(certs[0]),
+		VerifiedAt:  "2021-06-01T12:00:00Z",
+	}
+	return e
+}
+
+// Detached CMS SignedData structures as written by signing tools.
+type (
+	testAttribute struct {
+		Type   asn1.ObjectIdentifier
+		Values []asn1.RawValue `asn1:"set"`
+	}
+	testSignerID struct {
+		Issuer       asn1.RawValue
+		SerialNumber *big.Int
+	}
+	testSignerInfo struct {
+		Version            int
+		SID                testSignerID
+		DigestAlgorithm    pkix.AlgorithmIdentifier
+		SignedAttributes   asn1.RawValue
+		SignatureAlgorithm pkix.AlgorithmIdentifier
+		Signature          []byte
+	}
+	testSignedData struct {
+		Version          int
+		DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+		EncapContentInfo struct{ EContentType asn1.ObjectIdentifier }
+		Certificates     asn1.RawValue
+		SignerInfos      []testSignerInfo `asn1:"set"`
+	}
+	testContentInfo struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue
+	}
+)
+
+func mustMarshal(v interface{}) []byte {
+	der, err := asn1.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return der
+}
+
+// signedContainer signs POA by its principal into detached CMS container bundling the signer certificate.
+func signedContainer(e *entity.POA) *entity.POA {
+	signer := testSigners[e.Principal.INN]
+	payload, err := e.SigningPayload()
+	if err != nil {
+		panic(err)
+	}
+	certs, _ := signature.ParseCertificates(signer.pem)
+	digest := sha256.Sum256(payload)
+
+	var attrs []byte
+	for _, attr := range []testAttribute{
+		{Type: cms.OIDAttributeContentType, Values: []asn1.RawValue{{FullBytes: mustMarshal(cms.OIDData)}}},
+		{Type: cms.OIDAttributeSigningTime, Values: []asn1.RawValue{{FullBytes: mustMarshal(time.Date(2021, 6, 1, 11, 0, 0, 0, time.UTC))}}},
+		{Type: cms.OIDAttributeMessageDigest, Values: []asn1.RawValue{{FullBytes: mustMarshal(digest[:])}}},
+	} {
+		attrs = append(attrs, mustMarshal(attr)...)
+	}
+	signedAttrs := sha256.Sum256(mustMarshal(asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: attrs}))
+	sig, err := signer.key.Sign(rand.Reader, signedAttrs[:], nil)
+	if err != nil {
+		panic(err)
+	}
+
+	digestAlgorithm := pkix.AlgorithmIdentifier{Algorithm: cms.OIDDigestSHA256}
+	signed := testSignedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{digestAlgorithm},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: certs[0].Raw},
+		SignerInfos: []testSignerInfo{{
+			Version:            1,
+			SID:                testSignerID{asn1.RawValue{FullBytes: certs[0].RawIssuer}, certs[0].SerialNumber},
+			DigestAlgorithm:    digestAlgorithm,
+			SignedAttributes:   asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: attrs},
+			SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}},
+			Signature:          sig,
+		}},
+	}
+	signed.EncapContentInfo.EContentType = cms.OIDData
+	container := mustMarshal(testContentInfo{
+		ContentType: cms.OIDSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: mustMarshal(signed)},
+	})
+
+	e.Signature = &entity.Signature{
+		Container:   base64.StdEncoding.EncodeToString(container),
+		Fingerprint: signature.Fingerprint(certs[0]),
+		VerifiedAt:  "2021-06-01T12:00:00Z",
+	}
+	return e
+}
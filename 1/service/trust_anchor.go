@@ -0,0 +1,69 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/repository"
+	"github.com/procsy-tech/attorney/signature"
+	"github.com/procsy-tech/attorney/utils/logs"
+)
+
+func NewTrustAnchorServiceImpl(
+	log logs.Logger,
+	rep repository.Repository,
+) TrustAnchorService {
+	return &TrustAnchorServiceImpl{
+		log,
+		rep,
+	}
+}
+
+type TrustAnchorServiceImpl struct {
+	log logs.Logger
+	rep repository.Repository
+}
+
+// AddTrustAnchor registers CA certificate which signer certificates may chain to.
+func (svc *TrustAnchorServiceImpl) AddTrustAnchor(Anchor *entity.TrustAnchor) error {
+	if Anchor == nil {
+		return &ValidationError{Field: "anchor", Reason: "required"}
+	}
+	certs, err := signature.ParseCertificates(Anchor.Certificate)
+	if err != nil {
+		return &ValidationError{Field: "certificate", Reason: err.Error()}
+	}
+	if len(certs) != 1 {
+		return &ValidationError{Field: "certificate", Reason: "exactly one certificate is expected"}
+	}
+	if !certs[0].IsCA {
+		return &ValidationError{Field: "certificate", Reason: "not a CA certificate"}
+	}
+	Anchor.Fingerprint = signature.Fingerprint(certs[0])
+
+	err = svc.rep.TrustAnchorRepository().New(Anchor)
+	if err != nil {
+		return fmt.Errorf("failed to save trust anchor: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteTrustAnchor removes trust anchor, signatures chaining to it are no longer trusted.
+func (svc *TrustAnchorServiceImpl) DeleteTrustAnchor(Fingerprint string) error {
+	if len(Fingerprint) == 0 {
+		return &ValidationError{Field: "fingerprint", Reason: "required"}
+	}
+
+	err := svc.rep.TrustAnchorRepository().DeleteByFingerprint(Fingerprint)
+	if err != nil {
+		return fmt.Errorf("failed to delete trust anchor: %s", err)
+	}
+
+	return nil
+}
+
+// ListTrustAnchors returns all trust anchors.
+func (svc *TrustAnchorServiceImpl) ListTrustAnchors() ([]entity.TrustAnchor, error) {
+	return svc.rep.TrustAnchorRepository().List()
+}
@@ -0,0 +1,286 @@
+// Package cms parses detached CMS SignedData containers (RFC 5652), also known as PKCS #7 and CAdES-BES
+// signatures, as produced by signing tools in .sig files.
+package cms
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"time"
+
+	"github.com/procsy-tech/attorney/signature/gost"
+)
+
+var (
+	ErrNotSignedData      = errors.New("cms: not a SignedData container")
+	ErrAttachedContent    = errors.New("cms: content is attached, detached signature expected")
+	ErrUnsupportedDigest  = errors.New("cms: unsupported digest algorithm")
+	ErrMissingAttribute   = errors.New("cms: required signed attribute is missing")
+	ErrContentType        = errors.New("cms: content type attribute does not match")
+	ErrDigestMismatch     = errors.New("cms: message digest does not match content")
+	ErrSignerNotFound     = errors.New("cms: signer certificate not found")
+	ErrMultipleAttributes = errors.New("cms: signed attribute has multiple values")
+)
+
+var (
+	OIDData       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	OIDSignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+
+	OIDAttributeContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	OIDAttributeMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	OIDAttributeSigningTime   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}
+
+	OIDDigestSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+)
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapsulatedContentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      []signerInfo  `asn1:"set"`
+}
+
+type encapsulatedContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type signerInfo struct {
+	Version            int
+	SID                asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttributes   asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttributes asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+// SignedData is a parsed detached CMS SignedData container.
+type SignedData struct {
+	// ContentType is the type of the signed content, id-data for files.
+	ContentType asn1.ObjectIdentifier
+	// Certificates are the certificates bundled into the container.
+	Certificates []*x509.Certificate
+	Signers      []*SignerInfo
+}
+
+// SignerInfo is a signature of the content made by one signer.
+type SignerInfo struct {
+	DigestAlgorithm asn1.ObjectIdentifier
+	// Signature is the signature value over SignedAttributes, or over the content when there are
+	// no signed attributes.
+	Signature []byte
+	// SignedAttributes is DER encoding of signed attributes which the signature is made over, nil if absent.
+	SignedAttributes []byte
+	// MessageDigest is the content digest from the signed attributes.
+	MessageDigest []byte
+	// SigningTime is the time claimed by the signer, zero if absent.
+	SigningTime time.Time
+
+	contentType  asn1.ObjectIdentifier
+	eContentType asn1.ObjectIdentifier
+	issuer       []byte
+	serialNumber *big.Int
+	subjectKeyID []byte
+}
+
+// Parse parses DER or PEM encoded CMS SignedData container with detached content.
+func Parse(data []byte) (*SignedData, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		data = block.Bytes
+	}
+
+	var info contentInfo
+	_, err := asn1.Unmarshal(data, &info)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", ErrNotSignedData, err)
+	}
+	if !info.ContentType.Equal(OIDSignedData) {
+		return nil, ErrNotSignedData
+	}
+
+	var sd signedData
+	_, err = asn1.Unmarshal(info.Content.Bytes, &sd)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", ErrNotSignedData, err)
+	}
+	if len(sd.EncapContentInfo.EContent.Bytes) != 0 {
+		return nil, ErrAttachedContent
+	}
+
+	result := &SignedData{ContentType: sd.EncapContentInfo.EContentType}
+	if len(sd.Certificates.Bytes) != 0 {
+		result.Certificates, err = x509.ParseCertificates(sd.Certificates.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("cms: failed to parse certificates: %s", err)
+		}
+	}
+
+	for inx := range sd.SignerInfos {
+		signer, err := parseSignerInfo(&sd.SignerInfos[inx])
+		if err != nil {
+			return nil, err
+		}
+		signer.eContentType = result.ContentType
+		result.Signers = append(result.Signers, signer)
+	}
+
+	return result, nil
+}
+
+func parseSignerInfo(info *signerInfo) (*SignerInfo, error) {
+	signer := &SignerInfo{
+		DigestAlgorithm: info.DigestAlgorithm.Algorithm,
+		Signature:       info.Signature,
+	}
+
+	switch {
+	case info.SID.Class == asn1.ClassUniversal && info.SID.Tag == asn1.TagSequence:
+		var sid issuerAndSerialNumber
+		_, err := asn1.Unmarshal(info.SID.FullBytes, &sid)
+		if err != nil {
+			return nil, fmt.Errorf("cms: invalid signer identifier: %s", err)
+		}
+		signer.issuer = sid.Issuer.FullBytes
+		signer.serialNumber = sid.SerialNumber
+	case info.SID.Class == asn1.ClassContextSpecific && info.SID.Tag == 0:
+		signer.subjectKeyID = info.SID.Bytes
+	default:
+		return nil, errors.New("cms: invalid signer identifier")
+	}
+
+	if len(info.SignedAttributes.FullBytes) == 0 {
+		return signer, nil
+	}
+
+	// The signature is made over DER encoding of SET OF Attribute, not of the implicitly tagged field.
+	signer.SignedAttributes = append([]byte{0x31}, info.SignedAttributes.FullBytes[1:]...)
+
+	rest := info.SignedAttributes.Bytes
+	for len(rest) != 0 {
+		var attr attribute
+		var err error
+		rest, err = asn1.Unmarshal(rest, &attr)
+		if err != nil {
+			return nil, fmt.Errorf("cms: invalid signed attribute: %s", err)
+		}
+		err = signer.setAttribute(&attr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if signer.contentType == nil || signer.MessageDigest == nil {
+		return nil, ErrMissingAttribute
+	}
+
+	return signer, nil
+}
+
+func (si *SignerInfo) setAttribute(attr *attribute) error {
+	var value interface{}
+	switch {
+	case attr.Type.Equal(OIDAttributeContentType):
+		value = &si.contentType
+	case attr.Type.Equal(OIDAttributeMessageDigest):
+		value = &si.MessageDigest
+	case attr.Type.Equal(OIDAttributeSigningTime):
+		value = &si.SigningTime
+	default:
+		return nil
+	}
+
+	if len(attr.Values) != 1 {
+		return ErrMultipleAttributes
+	}
+	_, err := asn1.Unmarshal(attr.Values[0].FullBytes, value)
+	if err != nil {
+		return fmt.Errorf("cms: invalid signed attribute %s: %s", attr.Type, err)
+	}
+	return nil
+}
+
+// Identifies reports whether the certificate is the one the signer info refers to.
+func (si *SignerInfo) Identifies(cert *x509.Certificate) bool {
+	if si.subjectKeyID != nil {
+		return bytes.Equal(si.subjectKeyID, cert.SubjectKeyId)
+	}
+	return bytes.Equal(si.issuer, cert.RawIssuer) && si.serialNumber.Cmp(cert.SerialNumber) == 0
+}
+
+// FindCertificate returns the signer certificate among the given ones.
+func (si *SignerInfo) FindCertificate(certs []*x509.Certificate) (*x509.Certificate, error) {
+	for _, cert := range certs {
+		if si.Identifies(cert) {
+			return cert, nil
+		}
+	}
+	return nil, ErrSignerNotFound
+}
+
+// SignedBytes returns the data the signature value is made over: signed attributes if present,
+// the detached content otherwise.
+func (si *SignerInfo) SignedBytes(content []byte) []byte {
+	if si.SignedAttributes != nil {
+		return si.SignedAttributes
+	}
+	return content
+}
+
+// CheckContent checks that the signed attributes refer to the detached content: the content type
+// and the message digest must match. Does nothing when there are no signed attributes.
+func (si *SignerInfo) CheckContent(content []byte) error {
+	if si.SignedAttributes == nil {
+		return nil
+	}
+	if !si.contentType.Equal(si.eContentType) {
+		return ErrContentType
+	}
+
+	h, err := NewHash(si.DigestAlgorithm)
+	if err != nil {
+		return err
+	}
+	h.Write(content)
+	if !bytes.Equal(h.Sum(nil), si.MessageDigest) {
+		return ErrDigestMismatch
+	}
+	return nil
+}
+
+// NewHash returns hash of the digest algorithm: SHA-256 or Streebog.
+func NewHash(algorithm asn1.ObjectIdentifier) (hash.Hash, error) {
+	switch {
+	case algorithm.Equal(OIDDigestSHA256):
+		return sha256.New(), nil
+	case algorithm.Equal(gost.OIDDigest256):
+		return gost.New256(), nil
+	case algorithm.Equal(gost.OIDDigest512):
+		return gost.New512(), nil
+	}
+	return nil, ErrUnsupportedDigest
+}
@@ -0,0 +1,77 @@
+package cms_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/procsy-tech/attorney/signature/cms"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func readTestdata(name string) []byte {
+	data, err := ioutil.ReadFile("testdata/" + name)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func TestParse(t *testing.T) {
+	Convey("Given detached container made by openssl cms -sign", t, func(c C) {
+		container := readTestdata("poa.json.sig")
+		content := readTestdata("poa.json")
+
+		c.Convey("It should parse certificates and signed attributes", func(c C) {
+			signed, err := cms.Parse(container)
+			So(err, ShouldBeNil)
+			So(signed.ContentType, ShouldResemble, cms.OIDData)
+			So(signed.Certificates, ShouldHaveLength, 2)
+			So(signed.Signers, ShouldHaveLength, 1)
+
+			signer := signed.Signers[0]
+			So(signer.DigestAlgorithm, ShouldResemble, cms.OIDDigestSHA256)
+			So(signer.MessageDigest, ShouldHaveLength, 32)
+			So(signer.SigningTime.Equal(time.Date(2026, 10, 18, 7, 56, 47, 0, time.UTC)), ShouldBeTrue)
+
+			cert, err := signer.FindCertificate(signed.Certificates)
+			So(err, ShouldBeNil)
+			So(cert.Subject.CommonName, ShouldEqual, "Test Signer")
+
+			c.Convey("and check the content digest", func(c C) {
+				So(signer.CheckContent(content), ShouldBeNil)
+				So(signer.CheckContent(append(content, ' ')), ShouldEqual, cms.ErrDigestMismatch)
+			})
+			c.Convey("and give the bytes the signature is made over", func(c C) {
+				err := cert.CheckSignature(x509.ECDSAWithSHA256, signer.SignedBytes(content), signer.Signature)
+				So(err, ShouldBeNil)
+			})
+		})
+		c.Convey("It should parse PEM encoded container", func(c C) {
+			signed, err := cms.Parse(pem.EncodeToMemory(&pem.Block{Type: "CMS", Bytes: container}))
+			So(err, ShouldBeNil)
+			So(signed.Signers, ShouldHaveLength, 1)
+		})
+		c.Convey("It should not find signer certificate among others", func(c C) {
+			signed, _ := cms.Parse(container)
+			var others []*x509.Certificate
+			for _, cert := range signed.Certificates {
+				if cert.Subject.CommonName != "Test Signer" {
+					others = append(others, cert)
+				}
+			}
+			_, err := signed.Signers[0].FindCertificate(others)
+			So(err, ShouldEqual, cms.ErrSignerNotFound)
+		})
+	})
+	Convey("It should reject data which is not a container", t, func(c C) {
+		_, err := cms.Parse(readTestdata("poa.json"))
+		So(err, ShouldNotBeNil)
+
+		block, _ := pem.Decode(readTestdata("ca.pem"))
+		_, err = cms.Parse(block.Bytes)
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,92 @@
+package signature
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"time"
+
+	"github.com/procsy-tech/attorney/signature/cms"
+	"github.com/procsy-tech/attorney/signature/gost"
+)
+
+var (
+	ErrSignerCount     = errors.New("exactly one signer is expected")
+	ErrSigningTime     = errors.New("signing time is later than verification time")
+	ErrDigestAlgorithm = errors.New("digest algorithm does not match signer key")
+)
+
+// VerifyContainer checks the detached CMS SignedData container of data and the signer certificate chain
+// against the trust anchors at the moment. The signer certificate is looked up among the certificates
+// of the container and the optional PEM chain, the rest of them are used as intermediate CA certificates.
+// Returns the signer certificate.
+func VerifyContainer(data []byte, container []byte, chain string, anchors []*x509.Certificate, at time.Time) (*x509.Certificate, error) {
+	signed, err := cms.Parse(container)
+	if err != nil {
+		return nil, err
+	}
+	if len(signed.Signers) != 1 {
+		return nil, ErrSignerCount
+	}
+	info := signed.Signers[0]
+
+	certs := signed.Certificates
+	if len(chain) != 0 {
+		extra, err := ParseCertificates(chain)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, extra...)
+	}
+	signer, err := info.FindCertificate(certs)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.DigestAlgorithm.Equal(digestAlgorithm(signer)) {
+		return nil, ErrDigestAlgorithm
+	}
+	err = info.CheckContent(data)
+	if err != nil {
+		return nil, err
+	}
+	if !info.SigningTime.IsZero() && info.SigningTime.After(at) {
+		return nil, ErrSigningTime
+	}
+
+	err = verifySignature(signer, info.SignedBytes(data), info.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	var intermediates []*x509.Certificate
+	for _, cert := range certs {
+		if cert != signer {
+			intermediates = append(intermediates, cert)
+		}
+	}
+	err = verifyChain(signer, intermediates, anchors, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer, nil
+}
+
+// digestAlgorithm returns the digest algorithm verifySignature uses with the signer key.
+func digestAlgorithm(signer *x509.Certificate) asn1.ObjectIdentifier {
+	switch signer.PublicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+		return cms.OIDDigestSHA256
+	}
+	pub, err := gost.ParsePublicKey(signer.RawSubjectPublicKeyInfo)
+	if err != nil {
+		return nil
+	}
+	if pub.Curve.Size == gost.Size256 {
+		return gost.OIDDigest256
+	}
+	return gost.OIDDigest512
+}
@@ -0,0 +1,148 @@
+package gost
+
+import (
+	"encoding/asn1"
+	"math/big"
+)
+
+func mustInt(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("gost: invalid curve constant")
+	}
+	return n
+}
+
+// Curves of GOST R 34.10-2012 and their parameter set names, see RFC 4357 and RFC 7836.
+var (
+	// CurveTest256 is id-GostR3410-2001-TestParamSet.
+	CurveTest256 = &Curve{
+		Name: "id-GostR3410-2001-TestParamSet",
+		Size: 32,
+		P:    mustInt("8000000000000000000000000000000000000000000000000000000000000431"),
+		A:    mustInt("0000000000000000000000000000000000000000000000000000000000000007"),
+		B:    mustInt("5fbff498aa938ce739b8e022fbafef40563f6e6a3472fc2a514c0ce9dae23b7e"),
+		Q:    mustInt("8000000000000000000000000000000150fe8a1892976154c59cfc193accf5b3"),
+		X:    mustInt("0000000000000000000000000000000000000000000000000000000000000002"),
+		Y:    mustInt("08e2a8a0e65147d4bd6316030e16d19c85c97f0a9ca267122b96abbcea7e8fc8"),
+	}
+	// CurveCryptoProA is id-GostR3410-2001-CryptoPro-A-ParamSet, also id-tc26-gost-3410-12-256-paramSetB and XchA.
+	CurveCryptoProA = &Curve{
+		Name: "id-GostR3410-2001-CryptoPro-A-ParamSet",
+		Size: 32,
+		P:    mustInt("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd97"),
+		A:    mustInt("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd94"),
+		B:    mustInt("00000000000000000000000000000000000000000000000000000000000000a6"),
+		Q:    mustInt("ffffffffffffffffffffffffffffffff6c611070995ad10045841b09b761b893"),
+		X:    mustInt("0000000000000000000000000000000000000000000000000000000000000001"),
+		Y:    mustInt("8d91e471e0989cda27df505a453f2b7635294f2ddf23e3b122acc99c9e9f1e14"),
+	}
+	// CurveCryptoProB is id-GostR3410-2001-CryptoPro-B-ParamSet, also id-tc26-gost-3410-12-256-paramSetC.
+	CurveCryptoProB = &Curve{
+		Name: "id-GostR3410-2001-CryptoPro-B-ParamSet",
+		Size: 32,
+		P:    mustInt("8000000000000000000000000000000000000000000000000000000000000c99"),
+		A:    mustInt("8000000000000000000000000000000000000000000000000000000000000c96"),
+		B:    mustInt("3e1af419a269a5f866a7d3c25c3df80ae979259373ff2b182f49d4ce7e1bbc8b"),
+		Q:    mustInt("800000000000000000000000000000015f700cfff1a624e5e497161bcc8a198f"),
+		X:    mustInt("0000000000000000000000000000000000000000000000000000000000000001"),
+		Y:    mustInt("3fa8124359f96680b83d1c3eb2c070e5c545c9858d03ecfb744bf8d717717efc"),
+	}
+	// CurveCryptoProC is id-GostR3410-2001-CryptoPro-C-ParamSet, also id-tc26-gost-3410-12-256-paramSetD and XchB.
+	CurveCryptoProC = &Curve{
+		Name: "id-GostR3410-2001-CryptoPro-C-ParamSet",
+		Size: 32,
+		P:    mustInt("9b9f605f5a858107ab1ec85e6b41c8aacf846e86789051d37998f7b9022d759b"),
+		A:    mustInt("9b9f605f5a858107ab1ec85e6b41c8aacf846e86789051d37998f7b9022d7598"),
+		B:    mustInt("000000000000000000000000000000000000000000000000000000000000805a"),
+		Q:    mustInt("9b9f605f5a858107ab1ec85e6b41c8aa582ca3511eddfb74f02f3a6598980bb9"),
+		X:    mustInt("0000000000000000000000000000000000000000000000000000000000000000"),
+		Y:    mustInt("41ece55743711a8c3cbf3783cd08c0ee4d4dc440d4641a8f366e550dfdb3bb67"),
+	}
+	// CurveTC26256A is id-tc26-gost-3410-12-256-paramSetA in Weierstrass form.
+	CurveTC26256A = &Curve{
+		Name: "id-tc26-gost-3410-12-256-paramSetA",
+		Size: 32,
+		P:    mustInt("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd97"),
+		A:    mustInt("c2173f1513981673af4892c23035a27ce25e2013bf95aa33b22c656f277e7335"),
+		B:    mustInt("295f9bae7428ed9ccc20e7c359a9d41a22fccd9108e17bf7ba9337a6f8ae9513"),
+		Q:    mustInt("400000000000000000000000000000000fd8cddfc87b6635c115af556c360c67"),
+		X:    mustInt("91e38443a5e82c0d880923425712b2bb658b9196932e02c78b2582fe742daa28"),
+		Y:    mustInt("32879423ab1a0375895786c4bb46e9565fde0b5344766740af268adb32322e5c"),
+	}
+	// CurveTest512 is id-tc26-gost-3410-12-512-paramSetTest.
+	CurveTest512 = &Curve{
+		Name: "id-tc26-gost-3410-12-512-paramSetTest",
+		Size: 64,
+		P:    mustInt("4531acd1fe0023c7550d267b6b2fee80922b14b2ffb90f04d4eb7c09b5d2d15df1d852741af4704a0458047e80e4546d35b8336fac224dd81664bbf528be6373"),
+		A:    mustInt("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007"),
+		B:    mustInt("1cff0806a31116da29d8cfa54e57eb748bc5f377e49400fdd788b649eca1ac4361834013b2ad7322480a89ca58e0cf74bc9e540c2add6897fad0a3084f302adc"),
+		Q:    mustInt("4531acd1fe0023c7550d267b6b2fee80922b14b2ffb90f04d4eb7c09b5d2d15da82f2d7ecb1dbac719905c5eecc423f1d86e25edbe23c595d644aaf187e6e6df"),
+		X:    mustInt("24d19cc64572ee30f396bf6ebbfd7a6c5213b3b3d7057cc825f91093a68cd762fd60611262cd838dc6b60aa7eee804e28bc849977fac33b4b530f1b120248a9a"),
+		Y:    mustInt("2bb312a43bd2ce6e0d020613c857acddcfbf061e91e5f2c3f32447c259f39b2c83ab156d77f1496bf7eb3351e1ee4e43dc1a18b91b24640b6dbb92cb1add371e"),
+	}
+	// CurveTC26512A is id-tc26-gost-3410-12-512-paramSetA.
+	CurveTC26512A = &Curve{
+		Name: "id-tc26-gost-3410-12-512-paramSetA",
+		Size: 64,
+		P:    mustInt("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffdc7"),
+		A:    mustInt("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffdc4"),
+		B:    mustInt("e8c2505dedfc86ddc1bd0b2b6667f1da34b82574761cb0e879bd081cfd0b6265ee3cb090f30d27614cb4574010da90dd862ef9d4ebee4761503190785a71c760"),
+		Q:    mustInt("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff27e69532f48d89116ff22b8d4e0560609b4b38abfad2b85dcacdb1411f10b275"),
+		X:    mustInt("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003"),
+		Y:    mustInt("7503cfe87a836ae3a61b8816e25450e6ce5e1c93acf1abc1778064fdcbefa921df1626be4fd036e93d75e6a50e3a41e98028fe5fc235f5b889a589cb5215f2a4"),
+	}
+	// CurveTC26512B is id-tc26-gost-3410-12-512-paramSetB.
+	CurveTC26512B = &Curve{
+		Name: "id-tc26-gost-3410-12-512-paramSetB",
+		Size: 64,
+		P:    mustInt("8000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006f"),
+		A:    mustInt("8000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006c"),
+		B:    mustInt("687d1b459dc841457e3e06cf6f5e2517b97c7d614af138bcbf85dc806c4b289f3e965d2db1416d217f8b276fad1ab69c50f78bee1fa3106efb8ccbc7c5140116"),
+		Q:    mustInt("800000000000000000000000000000000000000000000000000000000000000149a1ec142565a545acfdb77bd9d40cfa8b996712101bea0ec6346c54374f25bd"),
+		X:    mustInt("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002"),
+		Y:    mustInt("1a8f7eda389b094c2c071e3647a8940f3c123b697578c213be6dd9e6c8ec7335dcb228fd1edf4a39152cbcaaf8c0398828041055f94ceeec7e21340780fe41bd"),
+	}
+	// CurveTC26512C is id-tc26-gost-3410-12-512-paramSetC in Weierstrass form.
+	CurveTC26512C = &Curve{
+		Name: "id-tc26-gost-3410-12-512-paramSetC",
+		Size: 64,
+		P:    mustInt("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffdc7"),
+		A:    mustInt("dc9203e514a721875485a529d2c722fb187bc8980eb866644de41c68e143064546e861c0e2c9edd92ade71f46fcf50ff2ad97f951fda9f2a2eb6546f39689bd3"),
+		B:    mustInt("b4c4ee28cebc6c2c8ac12952cf37f16ac7efb6a9f69f4b57ffda2e4f0de5ade038cbc2fff719d2c18de0284b8bfef3b52b8cc7a5f5bf0a3c8d2319a5312557e1"),
+		Q:    mustInt("3fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc98cdba46506ab004c33a9ff5147502cc8eda9e7a769a12694623cef47f023ed"),
+		X:    mustInt("e2e31edfc23de7bdebe241ce593ef5de2295b7a9cbaef021d385f7074cea043aa27272a7ae602bf2a7b9033db9ed3610c6fb85487eae97aac5bc7928c1950148"),
+		Y:    mustInt("f5ce40d95b5eb899abbccff5911cb8577939804d6527378b8c108c3d2090ff9be18e2d33e3021ed2ef32d85822423b6304f726aa854bae07d0396e9a9addc40f"),
+	}
+)
+
+// curves maps public key parameter set OIDs to curves.
+var curves = []struct {
+	oid   asn1.ObjectIdentifier
+	curve *Curve
+}{
+	{asn1.ObjectIdentifier{1, 2, 643, 2, 2, 35, 0}, CurveTest256},
+	{asn1.ObjectIdentifier{1, 2, 643, 2, 2, 35, 1}, CurveCryptoProA},
+	{asn1.ObjectIdentifier{1, 2, 643, 2, 2, 35, 2}, CurveCryptoProB},
+	{asn1.ObjectIdentifier{1, 2, 643, 2, 2, 35, 3}, CurveCryptoProC},
+	{asn1.ObjectIdentifier{1, 2, 643, 2, 2, 36, 0}, CurveCryptoProA},
+	{asn1.ObjectIdentifier{1, 2, 643, 2, 2, 36, 1}, CurveCryptoProC},
+	{asn1.ObjectIdentifier{1, 2, 643, 7, 1, 2, 1, 1, 1}, CurveTC26256A},
+	{asn1.ObjectIdentifier{1, 2, 643, 7, 1, 2, 1, 1, 2}, CurveCryptoProA},
+	{asn1.ObjectIdentifier{1, 2, 643, 7, 1, 2, 1, 1, 3}, CurveCryptoProB},
+	{asn1.ObjectIdentifier{1, 2, 643, 7, 1, 2, 1, 1, 4}, CurveCryptoProC},
+	{asn1.ObjectIdentifier{1, 2, 643, 7, 1, 2, 1, 2, 0}, CurveTest512},
+	{asn1.ObjectIdentifier{1, 2, 643, 7, 1, 2, 1, 2, 1}, CurveTC26512A},
+	{asn1.ObjectIdentifier{1, 2, 643, 7, 1, 2, 1, 2, 2}, CurveTC26512B},
+	{asn1.ObjectIdentifier{1, 2, 643, 7, 1, 2, 1, 2, 3}, CurveTC26512C},
+}
+
+// CurveByOID returns the curve of the public key parameter set.
+func CurveByOID(oid asn1.ObjectIdentifier) (*Curve, bool) {
+	for _, entry := range curves {
+		if entry.oid.Equal(oid) {
+			return entry.curve, true
+		}
+	}
+	return nil, false
+}
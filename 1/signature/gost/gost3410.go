@@ -0,0 +1,176 @@
+package gost
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+)
+
+var (
+	ErrInvalidPublicKey = errors.New("gost: invalid public key")
+)
+
+// Curve is an elliptic curve y^2 = x^3 + ax + b (mod p) in short Weierstrass form
+// with the base point (x, y) of prime order q.
+type Curve struct {
+	Name string
+	// Size of the curve point coordinates and the signature parts in bytes: 32 or 64.
+	Size int
+
+	P, A, B, Q, X, Y *big.Int
+}
+
+// PublicKey is GOST R 34.10-2012 verification key.
+type PublicKey struct {
+	Curve *Curve
+	X, Y  *big.Int
+}
+
+// NewPublicKey decodes public key given as little-endian x followed by little-endian y.
+func NewPublicKey(curve *Curve, raw []byte) (*PublicKey, error) {
+	if len(raw) != 2*curve.Size {
+		return nil, ErrInvalidPublicKey
+	}
+	pub := &PublicKey{
+		Curve: curve,
+		X:     leToInt(raw[:curve.Size]),
+		Y:     leToInt(raw[curve.Size:]),
+	}
+	if !curve.IsOnCurve(pub.X, pub.Y) {
+		return nil, ErrInvalidPublicKey
+	}
+	return pub, nil
+}
+
+// NewHash returns Streebog hash of the key size: 256-bit for 256-bit keys, 512-bit otherwise.
+func (pub *PublicKey) NewHash() hash.Hash {
+	if pub.Curve.Size == Size256 {
+		return New256()
+	}
+	return New512()
+}
+
+// VerifyDigest checks signature of the digest. The signature is s followed by r, both big-endian,
+// as in X.509 and CMS (RFC 4491). The digest is interpreted as little-endian integer.
+func (pub *PublicKey) VerifyDigest(digest []byte, sig []byte) bool {
+	c := pub.Curve
+	if len(sig) != 2*c.Size {
+		return false
+	}
+	s := new(big.Int).SetBytes(sig[:c.Size])
+	r := new(big.Int).SetBytes(sig[c.Size:])
+	if r.Sign() <= 0 || r.Cmp(c.Q) >= 0 || s.Sign() <= 0 || s.Cmp(c.Q) >= 0 {
+		return false
+	}
+
+	e := leToInt(digest)
+	e.Mod(e, c.Q)
+	if e.Sign() == 0 {
+		e.SetInt64(1)
+	}
+
+	v := new(big.Int).ModInverse(e, c.Q)
+	z1 := new(big.Int).Mul(s, v)
+	z1.Mod(z1, c.Q)
+	z2 := new(big.Int).Mul(r, v)
+	z2.Neg(z2)
+	z2.Mod(z2, c.Q)
+
+	x1, y1 := c.scalarMult(c.X, c.Y, z1)
+	x2, y2 := c.scalarMult(pub.X, pub.Y, z2)
+	x, _ := c.add(x1, y1, x2, y2)
+	if x == nil {
+		return false
+	}
+
+	x.Mod(x, c.Q)
+	return x.Cmp(r) == 0
+}
+
+// Verify checks signature of the message.
+func (pub *PublicKey) Verify(message []byte, sig []byte) bool {
+	h := pub.NewHash()
+	h.Write(message)
+	return pub.VerifyDigest(h.Sum(nil), sig)
+}
+
+// IsOnCurve reports whether the point lies on the curve.
+func (c *Curve) IsOnCurve(x, y *big.Int) bool {
+	if x.Sign() < 0 || x.Cmp(c.P) >= 0 || y.Sign() < 0 || y.Cmp(c.P) >= 0 {
+		return false
+	}
+	left := new(big.Int).Mul(y, y)
+	left.Mod(left, c.P)
+
+	right := new(big.Int).Mul(x, x)
+	right.Add(right, c.A)
+	right.Mul(right, x)
+	right.Add(right, c.B)
+	right.Mod(right, c.P)
+
+	return left.Cmp(right) == 0
+}
+
+// add returns sum of points in affine coordinates, nil x stands for the point at infinity.
+func (c *Curve) add(x1, y1, x2, y2 *big.Int) (*big.Int, *big.Int) {
+	if x1 == nil {
+		return x2, y2
+	}
+	if x2 == nil {
+		return x1, y1
+	}
+
+	var lambda *big.Int
+	if x1.Cmp(x2) == 0 {
+		sum := new(big.Int).Add(y1, y2)
+		if sum.Mod(sum, c.P).Sign() == 0 {
+			return nil, nil
+		}
+		// lambda = (3x^2 + a) / 2y
+		num := new(big.Int).Mul(x1, x1)
+		num.Mul(num, big.NewInt(3))
+		num.Add(num, c.A)
+		den := new(big.Int).Lsh(y1, 1)
+		den.ModInverse(den.Mod(den, c.P), c.P)
+		lambda = num.Mul(num, den)
+	} else {
+		// lambda = (y2 - y1) / (x2 - x1)
+		num := new(big.Int).Sub(y2, y1)
+		den := new(big.Int).Sub(x2, x1)
+		den.ModInverse(den.Mod(den, c.P), c.P)
+		lambda = num.Mul(num, den)
+	}
+	lambda.Mod(lambda, c.P)
+
+	x := new(big.Int).Mul(lambda, lambda)
+	x.Sub(x, x1)
+	x.Sub(x, x2)
+	x.Mod(x, c.P)
+
+	y := new(big.Int).Sub(x1, x)
+	y.Mul(y, lambda)
+	y.Sub(y, y1)
+	y.Mod(y, c.P)
+
+	return x, y
+}
+
+// scalarMult returns k*(x, y).
+func (c *Curve) scalarMult(x, y, k *big.Int) (*big.Int, *big.Int) {
+	var rx, ry *big.Int
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		rx, ry = c.add(rx, ry, rx, ry)
+		if k.Bit(i) == 1 {
+			rx, ry = c.add(rx, ry, x, y)
+		}
+	}
+	return rx, ry
+}
+
+func leToInt(p []byte) *big.Int {
+	be := make([]byte, len(p))
+	for i := range p {
+		be[len(p)-1-i] = p[i]
+	}
+	return new(big.Int).SetBytes(be)
+}
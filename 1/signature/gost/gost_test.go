@@ -0,0 +1,132 @@
+package gost
+
+import (
+	"encoding/hex"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Examples of GOST R 34.11-2012, see RFC 6986 section 10.
+var streebogVectors = []struct {
+	message string
+	sum256  string
+	sum512  string
+}{
+	{
+		message: hex.EncodeToString([]byte("012345678901234567890123456789012345678901234567890123456789012")),
+		sum256:  "9d151eefd8590b89daa6ba6cb74af9275dd051026bb149a452fd84e5e57b5500",
+		sum512:  "1b54d01a4af5b9d5cc3d86d68d285462b19abc2475222f35c085122be4ba1ffa00ad30f8767b3a82384c6574f024c311e2a481332b08ef7f41797891c1646f48",
+	},
+	{
+		message: "d1e520e2e5f2f0e82c20d1f2f0e8e1eee6e820e2edf3f6e82c20e2e5fef2fa20f120eceef0ff20f1f2f0e5ebe0ece820ede020f5f0e0e1f0fbff20efebfaeafb20c8e3eef0e5e2fb",
+		sum256:  "9dd2fe4e90409e5da87f53976d7405b0c0cac628fc669a741d50063c557e8f50",
+		sum512:  "1e88e62226bfca6f9994f1f2d51569e0daf8475a3b0fe61a5300eee46d961376035fe83549ada2b8620fcd7c496ce5b33f0cb9dddc2b6460143b03dabac9fb28",
+	},
+}
+
+// Signatures made by libgcrypt: the public key is little-endian x followed by little-endian y,
+// the signature is s followed by r.
+var signatureVectors = []struct {
+	curve     *Curve
+	publicKey string
+	message   string
+	signature string
+}{
+	{
+		curve:     CurveCryptoProA,
+		publicKey: "815322e86cc0a673c4202fbe9907f7b006761f0c54879c3b2a1cd914114db19be3ab162f58961fc592cc7782a707d6e85b31b44c68d94669fa240f7496a13e58",
+		message:   "20d5d93f84e4a97f0fb8ce0d52209b7650864024b14740aa23553050b319825577f853cbfde20a7731e34c1108559f0194ba",
+		signature: "d071763771945e33872e9e223b3e4b9ff4d849cdd1dfa5a3e57635f75f0f503564c59b589f76eba8dfb9fae9a94e367f287b419cc1609746a84c889f7e80140a",
+	},
+	{
+		curve:     CurveTC26256A,
+		publicKey: "078417fb557aa9ff9c928f278cc7da240ac7d4807415eeb00481a317f9c03f915f62568ea53036978c392dda4079f14fa67ec3070644c06ee5fc569a7e3bde7f",
+		message:   "257501a6cabee81ffa74d3dd5bd99382445df10cad4830f314e1c256eb063a4cc2221c28ac4a4631e567f000edf027807ab0",
+		signature: "1ccacc4f0660803daf2e1b4f96586190d38e3a840253fd354969d7430bb59fb12cfb131233fef1504c7cb60ace1aea50f2328a746abd3fc6c31f29e3847d0fda",
+	},
+	{
+		curve:     CurveTC26512A,
+		publicKey: "15c35439527217c4df7b6f627397cad41369f4bd76cffa6c17e5da2f0ada81a5ee37ce00bf2981540cd691a13f31b2a2ddd2956547ef32f0448423d8bf32267cd58873e2ad177d2a08766f0620fcff8f9b7ac92da7f835688ffd5890c642397a2190d7f381ed0d32892f353b1c35e971d346b9d352d6480e223845e0001b9a45",
+		message:   "8e6719fd28596ee9e4c42f78b39e3e7ba41ecbf5d85c10c2030224137da3766e4f48c8f2a94796f438080f98ac89fd0239a1",
+		signature: "d4508c9ddcd9952f060ceff13edd8cd9e5d21706a01b55e3267bd34fd1e5c054418c2d6f9c84f630d185f76c440740e1de7fe899f8a6221aa9233d1571bf3974cfa5f76cf4d4b5b5296d1b7afd3f9fcf68fe9ad79e7a3b2d87ef64e6cb0f5bc281e9032fab001f55f85ed4185cff98de1e53a076de92752f53022f83a9185519",
+	},
+	{
+		curve:     CurveTC26512B,
+		publicKey: "b8b728bde47cc954d2d8b42cb17fcad0af034b5fdda9f887f80a9ed170eba66906b395b7b95d3afb4ccdafcd1f181a129bb9a2ec13c8733860c24a6a01ab071191b603929ef7e70998c55e6063f5771d49a33173b0cd0a893b9811ff12354cc1c13b24b84121b1914de1702830c7ae40f0eb5682f6baa2995bdc307eef7dc83e",
+		message:   "1187ab587a9256f40c4cb426c2b4d72f78c8c01a19af35f4320f6dff4831398e44511bff6849fd9c0f7d2949bc2a63c6ccf8",
+		signature: "6071319bfa3711d3a4313c1f48072e7beef28d954895ed76d7c15868dca4533d6e3e34ad772ba9ac2e27993af3632a5505d6570f653291e86d91bb06e179f721690a7312708029b1785fdd40a3943d46b51c79e166e2860ab8dd2d100e8de4c17e6578bd30f18fed4f59a33bc4a841505d670aaf0e8f46c9169ea5e26b8df0f8",
+	},
+}
+
+func TestStreebog(t *testing.T) {
+	Convey("It should hash RFC 6986 examples", t, func(c C) {
+		for _, vector := range streebogVectors {
+			h := New256()
+			h.Write(decodeHex(vector.message))
+			So(hex.EncodeToString(h.Sum(nil)), ShouldEqual, vector.sum256)
+
+			h = New512()
+			h.Write(decodeHex(vector.message))
+			So(hex.EncodeToString(h.Sum(nil)), ShouldEqual, vector.sum512)
+		}
+	})
+	Convey("It should hash the message written in parts", t, func(c C) {
+		message := decodeHex(streebogVectors[1].message)
+		h := New512()
+		h.Write(message[:10])
+		h.Write(message[10:])
+		So(hex.EncodeToString(h.Sum(nil)), ShouldEqual, streebogVectors[1].sum512)
+	})
+}
+
+func TestCurves(t *testing.T) {
+	Convey("Base point of every curve should be on the curve and of order q", t, func(c C) {
+		for _, entry := range curves {
+			So(entry.curve.IsOnCurve(entry.curve.X, entry.curve.Y), ShouldBeTrue)
+			x, _ := entry.curve.scalarMult(entry.curve.X, entry.curve.Y, entry.curve.Q)
+			So(x, ShouldBeNil)
+		}
+	})
+}
+
+func TestVerify(t *testing.T) {
+	Convey("It should verify libgcrypt signatures", t, func(c C) {
+		for _, vector := range signatureVectors {
+			pub, err := NewPublicKey(vector.curve, decodeHex(vector.publicKey))
+			So(err, ShouldBeNil)
+			So(pub.Verify(decodeHex(vector.message), decodeHex(vector.signature)), ShouldBeTrue)
+
+			c.Convey("and reject them over other message for "+vector.curve.Name, func(c C) {
+				So(pub.Verify(decodeHex(vector.message)[1:], decodeHex(vector.signature)), ShouldBeFalse)
+			})
+			c.Convey("and reject tampered signature for "+vector.curve.Name, func(c C) {
+				sig := decodeHex(vector.signature)
+				sig[len(sig)-1] ^= 1
+				So(pub.Verify(decodeHex(vector.message), sig), ShouldBeFalse)
+			})
+		}
+	})
+	Convey("It should reject point not on the curve", t, func(c C) {
+		raw := decodeHex(signatureVectors[0].publicKey)
+		raw[0] ^= 1
+		_, err := NewPublicKey(CurveCryptoProA, raw)
+		So(err, ShouldEqual, ErrInvalidPublicKey)
+	})
+	Convey("It should reject signature parts out of range", t, func(c C) {
+		pub, _ := NewPublicKey(CurveCryptoProA, decodeHex(signatureVectors[0].publicKey))
+		q := CurveCryptoProA.Q.Bytes()
+		sig := make([]byte, 64)
+		sig[31] = 1
+		copy(sig[64-len(q):], q)
+		So(pub.Verify(decodeHex(signatureVectors[0].message), sig), ShouldBeFalse)
+	})
+}
@@ -0,0 +1,118 @@
+package gost
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNotGOST = errors.New("gost: not a GOST R 34.10-2012 key or signature")
+)
+
+var (
+	OIDPublicKey256 = asn1.ObjectIdentifier{1, 2, 643, 7, 1, 1, 1, 1}
+	OIDPublicKey512 = asn1.ObjectIdentifier{1, 2, 643, 7, 1, 1, 1, 2}
+	OIDSignature256 = asn1.ObjectIdentifier{1, 2, 643, 7, 1, 1, 3, 2}
+	OIDSignature512 = asn1.ObjectIdentifier{1, 2, 643, 7, 1, 1, 3, 3}
+	OIDDigest256    = asn1.ObjectIdentifier{1, 2, 643, 7, 1, 1, 2, 2}
+	OIDDigest512    = asn1.ObjectIdentifier{1, 2, 643, 7, 1, 1, 2, 3}
+)
+
+type subjectPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// publicKeyParameters is GostR3410-2012-PublicKeyParameters, see RFC 4491 and RFC 7836.
+type publicKeyParameters struct {
+	PublicKeyParamSet asn1.ObjectIdentifier
+	DigestParamSet    asn1.ObjectIdentifier `asn1:"optional"`
+}
+
+type certificate struct {
+	TBSCertificate     asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	SignatureValue     asn1.BitString
+}
+
+// IsGOSTKey reports whether the certificate holds GOST R 34.10-2012 public key.
+func IsGOSTKey(cert *x509.Certificate) bool {
+	_, err := ParsePublicKey(cert.RawSubjectPublicKeyInfo)
+	return err == nil
+}
+
+// ParsePublicKey parses DER encoded SubjectPublicKeyInfo with GOST R 34.10-2012 public key.
+func ParsePublicKey(der []byte) (*PublicKey, error) {
+	var spki subjectPublicKeyInfo
+	rest, err := asn1.Unmarshal(der, &spki)
+	if err != nil || len(rest) != 0 {
+		return nil, ErrNotGOST
+	}
+
+	var size int
+	switch {
+	case spki.Algorithm.Algorithm.Equal(OIDPublicKey256):
+		size = Size256
+	case spki.Algorithm.Algorithm.Equal(OIDPublicKey512):
+		size = Size512
+	default:
+		return nil, ErrNotGOST
+	}
+
+	var params publicKeyParameters
+	_, err = asn1.Unmarshal(spki.Algorithm.Parameters.FullBytes, &params)
+	if err != nil {
+		return nil, fmt.Errorf("gost: invalid public key parameters: %s", err)
+	}
+	curve, ok := CurveByOID(params.PublicKeyParamSet)
+	if !ok {
+		return nil, fmt.Errorf("gost: unsupported public key parameter set %s", params.PublicKeyParamSet)
+	}
+	if curve.Size != size {
+		return nil, fmt.Errorf("gost: parameter set %s does not match key size", params.PublicKeyParamSet)
+	}
+
+	var raw []byte
+	_, err = asn1.Unmarshal(spki.PublicKey.RightAlign(), &raw)
+	if err != nil {
+		return nil, fmt.Errorf("gost: invalid public key encoding: %s", err)
+	}
+
+	return NewPublicKey(curve, raw)
+}
+
+// CheckCertificateSignature checks that the certificate is signed with the issuer GOST key.
+func CheckCertificateSignature(cert *x509.Certificate, issuer *PublicKey) error {
+	var outer certificate
+	_, err := asn1.Unmarshal(cert.Raw, &outer)
+	if err != nil {
+		return fmt.Errorf("gost: failed to parse certificate: %s", err)
+	}
+
+	expected := OIDSignature256
+	if issuer.Curve.Size == Size512 {
+		expected = OIDSignature512
+	}
+	if !outer.SignatureAlgorithm.Algorithm.Equal(expected) {
+		return ErrNotGOST
+	}
+
+	if !issuer.Verify(outer.TBSCertificate.FullBytes, outer.SignatureValue.RightAlign()) {
+		return errors.New("gost: certificate signature does not match")
+	}
+	return nil
+}
+
+// IsGOSTSigned reports whether the certificate is signed with GOST R 34.10-2012 algorithm.
+func IsGOSTSigned(cert *x509.Certificate) bool {
+	var outer certificate
+	_, err := asn1.Unmarshal(cert.Raw, &outer)
+	if err != nil {
+		return false
+	}
+	return outer.SignatureAlgorithm.Algorithm.Equal(OIDSignature256) ||
+		outer.SignatureAlgorithm.Algorithm.Equal(OIDSignature512)
+}
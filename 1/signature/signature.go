@@ -0,0 +1,229 @@
+// Package signature verifies detached signatures of POA documents made by X.509 certificate holders.
+package signature
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/procsy-tech/attorney/signature/gost"
+)
+
+var (
+	ErrNoCertificate        = errors.New("no certificate found")
+	ErrUnsupportedAlgorithm = errors.New("unsupported public key algorithm")
+	ErrInvalidSignature     = errors.New("signature does not match")
+	ErrUntrustedCertificate = errors.New("certificate is not issued by a trust anchor")
+	ErrChainTooLong         = errors.New("certificate chain is too long")
+)
+
+// maxChainLength limits the number of intermediate CA certificates in GOST certificate chains.
+const maxChainLength = 8
+
+var (
+	// OIDINN is INN of an individual in the certificate subject.
+	OIDINN = asn1.ObjectIdentifier{1, 2, 643, 3, 131, 1, 1}
+	// OIDINNLE is INN of a legal entity in the certificate subject.
+	OIDINNLE = asn1.ObjectIdentifier{1, 2, 643, 100, 4}
+)
+
+// ParseCertificates parses PEM encoded certificates, the first one is the signer certificate
+// and the rest are intermediate CA certificates.
+func ParseCertificates(data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %s", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, ErrNoCertificate
+	}
+	return certs, nil
+}
+
+// Fingerprint returns hex encoded SHA-256 of the certificate DER.
+func Fingerprint(cert *x509.Certificate) string {
+	h := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(h[:])
+}
+
+// SubjectINNs returns INN values of the certificate subject, legal entity INN goes first.
+func SubjectINNs(cert *x509.Certificate) []string {
+	var inns []string
+	for _, oid := range []asn1.ObjectIdentifier{OIDINNLE, OIDINN} {
+		inns = append(inns, subjectValues(cert.Subject, oid)...)
+	}
+	return inns
+}
+
+func subjectValues(name pkix.Name, oid asn1.ObjectIdentifier) []string {
+	var values []string
+	for _, attr := range name.Names {
+		if !attr.Type.Equal(oid) {
+			continue
+		}
+		if value, ok := attr.Value.(string); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// Verify checks the detached signature of data made by the first certificate of the PEM chain
+// and the chain itself against the trust anchors at the moment. ECDSA signatures are ASN.1 encoded,
+// RSA signatures are PKCS #1 v1.5, both over SHA-256 digest. GOST R 34.10-2012 signatures are s
+// followed by r over Streebog digest of the key size. Returns the signer certificate.
+func Verify(data []byte, sig []byte, chain string, anchors []*x509.Certificate, at time.Time) (*x509.Certificate, error) {
+	certs, err := ParseCertificates(chain)
+	if err != nil {
+		return nil, err
+	}
+	signer := certs[0]
+
+	err = verifySignature(signer, data, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyChain(signer, certs[1:], anchors, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer, nil
+}
+
+func verifySignature(signer *x509.Certificate, data []byte, sig []byte) error {
+	var algorithm x509.SignatureAlgorithm
+	switch signer.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		algorithm = x509.ECDSAWithSHA256
+	case *rsa.PublicKey:
+		algorithm = x509.SHA256WithRSA
+	default:
+		pub, err := gost.ParsePublicKey(signer.RawSubjectPublicKeyInfo)
+		if err != nil {
+			return ErrUnsupportedAlgorithm
+		}
+		if !pub.Verify(data, sig) {
+			return ErrInvalidSignature
+		}
+		return nil
+	}
+
+	if signer.CheckSignature(algorithm, data, sig) != nil {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func verifyChain(signer *x509.Certificate, intermediates []*x509.Certificate, anchors []*x509.Certificate, at time.Time) error {
+	if gost.IsGOSTSigned(signer) {
+		return verifyGOSTChain(signer, intermediates, anchors, at)
+	}
+	for _, cert := range intermediates {
+		if gost.IsGOSTSigned(cert) {
+			return verifyGOSTChain(signer, intermediates, anchors, at)
+		}
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         x509.NewCertPool(),
+		Intermediates: x509.NewCertPool(),
+		CurrentTime:   at,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	for _, cert := range anchors {
+		opts.Roots.AddCert(cert)
+	}
+	for _, cert := range intermediates {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := signer.Verify(opts)
+	if err != nil {
+		return fmt.Errorf("%s: %s", ErrUntrustedCertificate, err)
+	}
+	return nil
+}
+
+// verifyGOSTChain builds the chain from the signer up to a trust anchor by hand, since crypto/x509
+// does not know GOST signature algorithms. Certificates must be valid at the moment and issuers must be CAs.
+func verifyGOSTChain(signer *x509.Certificate, intermediates []*x509.Certificate, anchors []*x509.Certificate, at time.Time) error {
+	cert := signer
+	for depth := 0; depth <= maxChainLength; depth++ {
+		err := checkValidity(cert, at)
+		if err != nil {
+			return fmt.Errorf("%s: %s", ErrUntrustedCertificate, err)
+		}
+
+		for _, anchor := range anchors {
+			if issuedBy(cert, anchor) {
+				err = checkValidity(anchor, at)
+				if err != nil {
+					return fmt.Errorf("%s: %s", ErrUntrustedCertificate, err)
+				}
+				return nil
+			}
+		}
+
+		var issuer *x509.Certificate
+		for _, candidate := range intermediates {
+			if candidate != cert && issuedBy(cert, candidate) {
+				issuer = candidate
+				break
+			}
+		}
+		if issuer == nil {
+			return fmt.Errorf("%s: no issuer found for %q", ErrUntrustedCertificate, cert.Subject.CommonName)
+		}
+		cert = issuer
+	}
+	return ErrChainTooLong
+}
+
+func checkValidity(cert *x509.Certificate, at time.Time) error {
+	if at.Before(cert.NotBefore) || at.After(cert.NotAfter) {
+		return fmt.Errorf("certificate %q is not valid at %s", cert.Subject.CommonName, at.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func issuedBy(cert *x509.Certificate, issuer *x509.Certificate) bool {
+	if !bytes.Equal(cert.RawIssuer, issuer.RawSubject) {
+		return false
+	}
+	if !issuer.BasicConstraintsValid || !issuer.IsCA {
+		return false
+	}
+	if issuer.KeyUsage != 0 && issuer.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return false
+	}
+
+	pub, err := gost.ParsePublicKey(issuer.RawSubjectPublicKeyInfo)
+	if err != nil {
+		return cert.CheckSignatureFrom(issuer) == nil
+	}
+	return gost.CheckCertificateSignature(cert, pub) == nil
+}
@@ -0,0 +1,213 @@
+package signature_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/procsy-tech/attorney/signature"
+	"github.com/procsy-tech/attorney/signature/cms"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var (
+	notBefore = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter  = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	txTime    = time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func issue(template, parent *x509.Certificate, pub crypto.PublicKey, priv crypto.Signer) (*x509.Certificate, string) {
+	template.NotBefore = notBefore
+	template.NotAfter = notAfter
+	if parent == nil {
+		parent = template
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, priv)
+	if err != nil {
+		panic(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+	return cert, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func caTemplate(serial int64, name string) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: name},
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+}
+
+func TestVerify(t *testing.T) {
+	Convey("Given root and intermediate CA", t, func(c C) {
+		rootKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		root, _ := issue(caTemplate(1, "Root"), nil, &rootKey.PublicKey, rootKey)
+		subKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		_, subPEM := issue(caTemplate(2, "Sub"), root, &subKey.PublicKey, rootKey)
+		sub, _ := signature.ParseCertificates(subPEM)
+
+		signer := &x509.Certificate{
+			SerialNumber: big.NewInt(3),
+			Subject: pkix.Name{
+				CommonName: "ПАО Сбербанк",
+				ExtraNames: []pkix.AttributeTypeAndValue{{Type: signature.OIDINNLE, Value: "7707083893"}},
+			},
+			KeyUsage: x509.KeyUsageDigitalSignature,
+		}
+		data := []byte(`{"date_from":"2021-01-01"}`)
+		digest := sha256.Sum256(data)
+
+		c.Convey("It should verify ECDSA signature through intermediate CA", func(c C) {
+			key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			cert, certPEM := issue(signer, sub[0], &key.PublicKey, subKey)
+			sig, _ := key.Sign(rand.Reader, digest[:], nil)
+
+			verified, err := signature.Verify(data, sig, certPEM+subPEM, []*x509.Certificate{root}, txTime)
+			So(err, ShouldBeNil)
+			So(signature.Fingerprint(verified), ShouldEqual, signature.Fingerprint(cert))
+			So(signature.SubjectINNs(verified), ShouldResemble, []string{"7707083893"})
+
+			c.Convey("and reject it without the intermediate CA", func(c C) {
+				_, err := signature.Verify(data, sig, certPEM, []*x509.Certificate{root}, txTime)
+				So(err, ShouldNotBeNil)
+			})
+			c.Convey("and reject it after certificate expiry", func(c C) {
+				_, err := signature.Verify(data, sig, certPEM+subPEM, []*x509.Certificate{root}, notAfter.AddDate(0, 0, 1))
+				So(err, ShouldNotBeNil)
+			})
+			c.Convey("and reject it over other data", func(c C) {
+				_, err := signature.Verify([]byte(`{}`), sig, certPEM+subPEM, []*x509.Certificate{root}, txTime)
+				So(err, ShouldEqual, signature.ErrInvalidSignature)
+			})
+		})
+		c.Convey("It should verify RSA signature", func(c C) {
+			key, _ := rsa.GenerateKey(rand.Reader, 2048)
+			_, certPEM := issue(signer, root, &key.PublicKey, rootKey)
+			sig, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+
+			_, err := signature.Verify(data, sig, certPEM, []*x509.Certificate{root}, txTime)
+			So(err, ShouldBeNil)
+		})
+		c.Convey("It should reject certificate of unknown CA", func(c C) {
+			otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			other, _ := issue(caTemplate(4, "Other"), nil, &otherKey.PublicKey, otherKey)
+			key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			_, certPEM := issue(signer, other, &key.PublicKey, otherKey)
+			sig, _ := key.Sign(rand.Reader, digest[:], nil)
+
+			_, err := signature.Verify(data, sig, certPEM, []*x509.Certificate{root}, txTime)
+			So(err, ShouldNotBeNil)
+		})
+		c.Convey("It should reject empty certificate", func(c C) {
+			_, err := signature.Verify(data, nil, "", []*x509.Certificate{root}, txTime)
+			So(err, ShouldEqual, signature.ErrNoCertificate)
+		})
+	})
+}
+
+func readTestdata(name string) string {
+	data, err := ioutil.ReadFile("testdata/" + name)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
+
+func TestVerifyGOST(t *testing.T) {
+	Convey("Given GOST root CA with 512-bit key and signer certificate issued through 256-bit intermediate CA", t, func(c C) {
+		root, _ := signature.ParseCertificates(readTestdata("gost_root.pem"))
+		chain := readTestdata("gost_chain.pem")
+		sig, _ := base64.StdEncoding.DecodeString(readTestdata("gost_signature.b64"))
+		data := []byte(`{"date_from":"2021-01-01"}`)
+
+		c.Convey("It should verify the signature and the chain", func(c C) {
+			verified, err := signature.Verify(data, sig, chain, root, txTime)
+			So(err, ShouldBeNil)
+			So(signature.SubjectINNs(verified), ShouldResemble, []string{"7707083893"})
+		})
+		c.Convey("It should reject the signature over other data", func(c C) {
+			_, err := signature.Verify([]byte(`{}`), sig, chain, root, txTime)
+			So(err, ShouldEqual, signature.ErrInvalidSignature)
+		})
+		c.Convey("It should reject the chain after certificate expiry", func(c C) {
+			_, err := signature.Verify(data, sig, chain, root, notAfter.AddDate(0, 0, 1))
+			So(err, ShouldNotBeNil)
+		})
+		c.Convey("It should reject the chain without the intermediate CA", func(c C) {
+			certs, _ := signature.ParseCertificates(chain)
+			signer := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certs[0].Raw}))
+			_, err := signature.Verify(data, sig, signer, root, txTime)
+			So(err, ShouldNotBeNil)
+		})
+		c.Convey("It should reject the chain of unknown CA", func(c C) {
+			otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			other, _ := issue(caTemplate(4, "Test GOST Root CA"), nil, &otherKey.PublicKey, otherKey)
+			_, err := signature.Verify(data, sig, chain, []*x509.Certificate{other}, txTime)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestVerifyContainer(t *testing.T) {
+	Convey("Given GOST detached container bundling signer and intermediate CA certificates", t, func(c C) {
+		root, _ := signature.ParseCertificates(readTestdata("gost_root.pem"))
+		container := []byte(readTestdata("gost_signature.sig"))
+		data := []byte(`{"date_from":"2021-01-01"}`)
+
+		c.Convey("It should verify the container and the chain", func(c C) {
+			verified, err := signature.VerifyContainer(data, container, "", root, txTime)
+			So(err, ShouldBeNil)
+			So(signature.SubjectINNs(verified), ShouldResemble, []string{"7707083893"})
+		})
+		c.Convey("It should reject the container of other data", func(c C) {
+			_, err := signature.VerifyContainer([]byte(`{}`), container, "", root, txTime)
+			So(err, ShouldEqual, cms.ErrDigestMismatch)
+		})
+		c.Convey("It should reject the container signed later than the moment", func(c C) {
+			_, err := signature.VerifyContainer(data, container, "", root, time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC))
+			So(err, ShouldEqual, signature.ErrSigningTime)
+		})
+		c.Convey("It should reject the container of unknown CA", func(c C) {
+			otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			other, _ := issue(caTemplate(4, "Test GOST Root CA"), nil, &otherKey.PublicKey, otherKey)
+			_, err := signature.VerifyContainer(data, container, "", []*x509.Certificate{other}, txTime)
+			So(err, ShouldNotBeNil)
+		})
+	})
+	Convey("Given ECDSA detached container made by openssl cms -sign", t, func(c C) {
+		root, _ := signature.ParseCertificates(readTestdata("../cms/testdata/ca.pem"))
+		container := []byte(readTestdata("../cms/testdata/poa.json.sig"))
+		data := []byte(readTestdata("../cms/testdata/poa.json"))
+		at := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		c.Convey("It should verify the container and the chain", func(c C) {
+			verified, err := signature.VerifyContainer(data, container, "", root, at)
+			So(err, ShouldBeNil)
+			So(signature.SubjectINNs(verified), ShouldResemble, []string{"7707083893"})
+		})
+		c.Convey("It should reject the container of other data", func(c C) {
+			_, err := signature.VerifyContainer(data[1:], container, "", root, at)
+			So(err, ShouldEqual, cms.ErrDigestMismatch)
+		})
+		c.Convey("It should reject raw signature passed as container", func(c C) {
+			_, err := signature.VerifyContainer(data, []byte(readTestdata("gost_signature.b64")), "", root, at)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
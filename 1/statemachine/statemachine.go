@@ -0,0 +1,180 @@
+// Package statemachine checks entity state transitions against a state model
+// described as a PlantUML state diagram (see stateModels in chaincode.yaml).
+package statemachine
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PseudoState is the PlantUML start and final pseudo state.
+const PseudoState = "[*]"
+
+var transitionRe = regexp.MustCompile(`^(\[\*\]|[^\s:-]+)\s*-+(?:\[[^\]]*\])?-*>\s*(\[\*\]|[^\s:]+)\s*(?::.*)?$`)
+
+type (
+	// Machine is a parsed state model.
+	Machine struct {
+		states   []string
+		initial  []string
+		next     map[string][]string
+		terminal map[string]bool
+	}
+
+	// TransitionError is returned when state can not be changed.
+	TransitionError struct {
+		From    string
+		To      string
+		Allowed []string
+	}
+)
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("state %s can not be set into '%s' (correct states: %v)", e.From, e.To, e.Allowed)
+}
+
+// Parse builds state machine from PlantUML state diagram transitions like "A --> B".
+// Transitions from [*] declare initial states, transitions into [*] declare terminal ones.
+func Parse(puml string) (*Machine, error) {
+	m := &Machine{
+		next:     map[string][]string{},
+		terminal: map[string]bool{},
+	}
+
+	for inx, line := range strings.Split(puml, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "'") || strings.HasPrefix(line, "@") {
+			continue
+		}
+
+		match := transitionRe.FindStringSubmatch(line)
+		if match == nil {
+			return nil, fmt.Errorf("line %d: unsupported statement %q", inx+1, line)
+		}
+		from, to := match[1], match[2]
+
+		switch {
+		case from == PseudoState && to == PseudoState:
+			return nil, fmt.Errorf("line %d: transition between pseudo states", inx+1)
+		case from == PseudoState:
+			m.addState(to)
+			m.initial = appendUnique(m.initial, to)
+		case to == PseudoState:
+			m.addState(from)
+			m.terminal[from] = true
+		default:
+			m.addState(from)
+			m.addState(to)
+			m.next[from] = appendUnique(m.next[from], to)
+		}
+	}
+
+	if len(m.initial) == 0 {
+		return nil, fmt.Errorf("state model has no initial state")
+	}
+
+	return m, nil
+}
+
+// MustParse is like Parse but panics on error.
+func MustParse(puml string) *Machine {
+	m, err := Parse(puml)
+	if err != nil {
+		panic(fmt.Sprintf("statemachine: %s", err))
+	}
+	return m
+}
+
+func (m *Machine) addState(state string) {
+	for _, s := range m.states {
+		if s == state {
+			return
+		}
+	}
+	m.states = append(m.states, state)
+}
+
+// States returns all states in order of declaration.
+func (m *Machine) States() []string {
+	return append([]string(nil), m.states...)
+}
+
+// InitialStates returns states reachable from [*].
+func (m *Machine) InitialStates() []string {
+	return append([]string(nil), m.initial...)
+}
+
+// Next returns states allowed next from the state. Empty state means [*].
+func (m *Machine) Next(from string) []string {
+	if from == "" || from == PseudoState {
+		return m.InitialStates()
+	}
+	return append([]string(nil), m.next[from]...)
+}
+
+// Sources returns states from which the state can be reached. [*] stands for initial states.
+func (m *Machine) Sources(to string) []string {
+	var sources []string
+	for _, s := range m.initial {
+		if s == to {
+			sources = append(sources, PseudoState)
+			break
+		}
+	}
+	for _, from := range m.states {
+		for _, s := range m.next[from] {
+			if s == to {
+				sources = append(sources, from)
+				break
+			}
+		}
+	}
+	return sources
+}
+
+// CanTransit reports whether transition is declared in the model.
+func (m *Machine) CanTransit(from, to string) bool {
+	for _, s := range m.Next(from) {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Transit checks transition and returns *TransitionError if it is not allowed.
+func (m *Machine) Transit(from, to string) error {
+	if m.CanTransit(from, to) {
+		return nil
+	}
+	if from == "" {
+		from = PseudoState
+	}
+	return &TransitionError{From: from, To: to, Allowed: m.Sources(to)}
+}
+
+// IsTerminal reports whether the state is final: it leads into [*] or has no outgoing transitions.
+func (m *Machine) IsTerminal(state string) bool {
+	return m.terminal[state] || len(m.next[state]) == 0
+}
+
+// TerminalStates returns terminal states in order of declaration.
+func (m *Machine) TerminalStates() []string {
+	var states []string
+	for _, s := range m.states {
+		if m.IsTerminal(s) {
+			states = append(states, s)
+		}
+	}
+	return states
+}
+
+func appendUnique(list []string, item string) []string {
+	for _, s := range list {
+		if s == item {
+			return list
+		}
+	}
+	return append(list, item)
+}
@@ -0,0 +1,72 @@
+package statemachine_test
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/procsy-tech/attorney/entity"
+	"github.com/procsy-tech/attorney/statemachine"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// stateModelFromChaincodeYaml extracts the first inline PlantUML state model from chaincode.yaml.
+func stateModelFromChaincodeYaml() (string, error) {
+	data, err := ioutil.ReadFile("../chaincode.yaml")
+	if err != nil {
+		return "", err
+	}
+	content := string(data)
+	start := strings.Index(content, "@startuml")
+	end := strings.Index(content, "@enduml")
+	if start < 0 || end < start {
+		return "", nil
+	}
+	return content[start : end+len("@enduml")], nil
+}
+
+func TestMachine(t *testing.T) {
+	Convey("Given POA state model", t, func(c C) {
+		m, err := statemachine.Parse(entity.POAStateModel)
+		So(err, ShouldBeNil)
+
+		c.Convey("It should list initial states", func(c C) {
+			So(m.InitialStates(), ShouldResemble, []string{"Created"})
+			So(m.Next(""), ShouldResemble, []string{"Created"})
+		})
+		c.Convey("It should list states allowed next", func(c C) {
+			So(m.Next("Created"), ShouldResemble, []string{"Sent"})
+			So(m.Next("Sent"), ShouldResemble, []string{"Returned", "Confirmed", "Rejected"})
+			So(m.Next("Returned"), ShouldResemble, []string{"Sent"})
+			So(m.Next("Confirmed"), ShouldResemble, []string{"Revoked"})
+			So(m.Next("Revoked"), ShouldBeEmpty)
+		})
+		c.Convey("It should check transitions", func(c C) {
+			So(m.Transit("Created", "Sent"), ShouldBeNil)
+			So(m.Transit("Returned", "Sent"), ShouldBeNil)
+			err := m.Transit("Confirmed", "Sent")
+			So(err, ShouldHaveSameTypeAs, &statemachine.TransitionError{})
+			So(err.(*statemachine.TransitionError).Allowed, ShouldResemble, []string{"Created", "Returned"})
+			So(m.Transit("", "Sent"), ShouldNotBeNil)
+		})
+		c.Convey("It should report terminal states", func(c C) {
+			So(m.TerminalStates(), ShouldResemble, []string{"Rejected", "Revoked"})
+			So(m.IsTerminal("Sent"), ShouldBeFalse)
+			So(m.IsTerminal("Confirmed"), ShouldBeFalse)
+		})
+		c.Convey("It should match the state model in chaincode.yaml", func(c C) {
+			model, err := stateModelFromChaincodeYaml()
+			So(err, ShouldBeNil)
+			fromYaml, err := statemachine.Parse(model)
+			So(err, ShouldBeNil)
+			So(fromYaml, ShouldResemble, m)
+		})
+	})
+
+	Convey("Given malformed state models", t, func(c C) {
+		_, err := statemachine.Parse("@startuml\n  Created => Sent\n@enduml")
+		So(err, ShouldNotBeNil)
+		_, err = statemachine.Parse("@startuml\n  Created --> Sent\n@enduml")
+		So(err, ShouldNotBeNil)
+	})
+}
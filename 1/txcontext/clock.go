@@ -0,0 +1,45 @@
+// Package txcontext provides transaction context values which are equal on all endorsing peers.
+package txcontext
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+type (
+	// Clock returns current time of the transaction.
+	Clock interface {
+		Now() (time.Time, error)
+	}
+
+	// ClockFunc adapts function to Clock.
+	ClockFunc func() (time.Time, error)
+
+	stubClock struct {
+		stub shim.ChaincodeStubInterface
+	}
+)
+
+// Now .
+func (f ClockFunc) Now() (time.Time, error) {
+	return f()
+}
+
+// Now returns transaction timestamp set by the client in the proposal.
+func (c *stubClock) Now() (time.Time, error) {
+	ts, err := c.stub.GetTxTimestamp()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get tx timestamp: %s", err)
+	}
+	if ts == nil {
+		return time.Time{}, fmt.Errorf("tx timestamp is not set")
+	}
+	return time.Unix(ts.Seconds, int64(ts.Nanos)).UTC(), nil
+}
+
+// NewClock returns clock based on the transaction timestamp, never on the local time of a peer.
+func NewClock(stub shim.ChaincodeStubInterface) Clock {
+	return &stubClock{stub}
+}
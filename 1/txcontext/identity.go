@@ -0,0 +1,74 @@
+package txcontext
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+type (
+	// Identity describes the client which submitted the transaction.
+	Identity interface {
+		// ID returns id of the client which is unique within its MSP.
+		ID() (string, error)
+		MSPID() (string, error)
+		// Attribute returns value of the attribute issued by Fabric CA in the client certificate.
+		Attribute(name string) (value string, found bool, err error)
+		// HasOU reports whether the client certificate subject contains the organizational unit.
+		HasOU(ou string) (bool, error)
+		// Certificate returns the client certificate.
+		Certificate() (*x509.Certificate, error)
+	}
+
+	stubIdentity struct {
+		stub shim.ChaincodeStubInterface
+	}
+)
+
+// ID .
+func (i *stubIdentity) ID() (string, error) {
+	return cid.GetID(i.stub)
+}
+
+// MSPID .
+func (i *stubIdentity) MSPID() (string, error) {
+	return cid.GetMSPID(i.stub)
+}
+
+// Attribute .
+func (i *stubIdentity) Attribute(name string) (string, bool, error) {
+	return cid.GetAttributeValue(i.stub, name)
+}
+
+// HasOU .
+func (i *stubIdentity) HasOU(ou string) (bool, error) {
+	cert, err := i.Certificate()
+	if err != nil {
+		return false, err
+	}
+	for _, value := range cert.Subject.OrganizationalUnit {
+		if value == ou {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Certificate .
+func (i *stubIdentity) Certificate() (*x509.Certificate, error) {
+	cert, err := cid.GetX509Certificate(i.stub)
+	if err != nil {
+		return nil, err
+	}
+	if cert == nil {
+		return nil, fmt.Errorf("client identity is not an x509 certificate")
+	}
+	return cert, nil
+}
+
+// NewIdentity returns identity of the transaction creator.
+func NewIdentity(stub shim.ChaincodeStubInterface) Identity {
+	return &stubIdentity{stub}
+}
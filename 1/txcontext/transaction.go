@@ -0,0 +1,26 @@
+package txcontext
+
+import (
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+type (
+	// Transaction describes the transaction being executed.
+	Transaction interface {
+		ID() string
+	}
+
+	stubTransaction struct {
+		stub shim.ChaincodeStubInterface
+	}
+)
+
+// ID returns the transaction id.
+func (t *stubTransaction) ID() string {
+	return t.stub.GetTxID()
+}
+
+// NewTransaction returns the transaction of the stub.
+func NewTransaction(stub shim.ChaincodeStubInterface) Transaction {
+	return &stubTransaction{stub}
+}
@@ -0,0 +1,147 @@
+// Package validation checks russian state registration identifiers: INN, OGRN, OGRNIP, KPP and SNILS.
+package validation
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidLength   = errors.New("invalid length")
+	ErrInvalidFormat   = errors.New("invalid format")
+	ErrInvalidChecksum = errors.New("invalid control digits")
+)
+
+var (
+	inn10Weights  = []int{2, 4, 10, 3, 5, 9, 4, 6, 8}
+	inn12Weights1 = []int{7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+	inn12Weights2 = []int{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+
+	kppRe = regexp.MustCompile(`^\d{4}[\dA-Z]{2}\d{3}$`)
+)
+
+// INN checks INN of a legal entity (10 digits) or of an individual (12 digits).
+func INN(value string) error {
+	switch len(value) {
+	case 10:
+		return INN10(value)
+	case 12:
+		return INN12(value)
+	}
+	return ErrInvalidLength
+}
+
+// INN10 checks INN of a legal entity.
+func INN10(value string) error {
+	digits, err := toDigits(value, 10)
+	if err != nil {
+		return err
+	}
+	if controlDigit(digits, inn10Weights) != digits[9] {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+// INN12 checks INN of an individual or an individual entrepreneur.
+func INN12(value string) error {
+	digits, err := toDigits(value, 12)
+	if err != nil {
+		return err
+	}
+	if controlDigit(digits, inn12Weights1) != digits[10] ||
+		controlDigit(digits, inn12Weights2) != digits[11] {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+// OGRN checks main state registration number of a legal entity (13 digits).
+func OGRN(value string) error {
+	return checkOGRN(value, 13, 11)
+}
+
+// OGRNIP checks main state registration number of an individual entrepreneur (15 digits).
+func OGRNIP(value string) error {
+	return checkOGRN(value, 15, 13)
+}
+
+// KPP checks format of tax registration reason code.
+func KPP(value string) error {
+	if len(value) != 9 {
+		return ErrInvalidLength
+	}
+	if !kppRe.MatchString(value) {
+		return ErrInvalidFormat
+	}
+	return nil
+}
+
+// SNILS checks insurance number of an individual. Separators "-" and " " are allowed.
+func SNILS(value string) error {
+	value = strings.NewReplacer("-", "", " ", "").Replace(value)
+	digits, err := toDigits(value, 11)
+	if err != nil {
+		return err
+	}
+
+	number, _ := strconv.Atoi(value[:9])
+	// control digits are defined only for numbers greater than 001-001-998
+	if number <= 1001998 {
+		return nil
+	}
+
+	sum := 0
+	for inx := 0; inx < 9; inx++ {
+		sum += digits[inx] * (9 - inx)
+	}
+	for sum > 101 {
+		sum %= 101
+	}
+	if sum == 100 || sum == 101 {
+		sum = 0
+	}
+
+	if sum != digits[9]*10+digits[10] {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+func checkOGRN(value string, length int, modulo uint64) error {
+	if _, err := toDigits(value, length); err != nil {
+		return err
+	}
+	number, err := strconv.ParseUint(value[:length-1], 10, 64)
+	if err != nil {
+		return ErrInvalidFormat
+	}
+	if byte(number%modulo%10) != value[length-1]-'0' {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+func controlDigit(digits []int, weights []int) int {
+	sum := 0
+	for inx, w := range weights {
+		sum += digits[inx] * w
+	}
+	return sum % 11 % 10
+}
+
+func toDigits(value string, length int) ([]int, error) {
+	if len(value) != length {
+		return nil, ErrInvalidLength
+	}
+	digits := make([]int, length)
+	for inx := 0; inx < length; inx++ {
+		if value[inx] < '0' || value[inx] > '9' {
+			return nil, ErrInvalidFormat
+		}
+		digits[inx] = int(value[inx] - '0')
+	}
+	return digits, nil
+}
@@ -0,0 +1,42 @@
+package validation
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestIdentifiers(t *testing.T) {
+	Convey("Given identifier validators", t, func(c C) {
+		c.Convey("It should check INN", func(c C) {
+			So(INN("7707083893"), ShouldBeNil)
+			So(INN("500100732259"), ShouldBeNil)
+			So(INN("7707083894"), ShouldEqual, ErrInvalidChecksum)
+			So(INN("500100732258"), ShouldEqual, ErrInvalidChecksum)
+			So(INN("77070838"), ShouldEqual, ErrInvalidLength)
+			So(INN("770708389A"), ShouldEqual, ErrInvalidFormat)
+			So(INN10("500100732259"), ShouldEqual, ErrInvalidLength)
+			So(INN12("7707083893"), ShouldEqual, ErrInvalidLength)
+		})
+		c.Convey("It should check OGRN and OGRNIP", func(c C) {
+			So(OGRN("1027700132195"), ShouldBeNil)
+			So(OGRN("1027700132194"), ShouldEqual, ErrInvalidChecksum)
+			So(OGRNIP("304500116000157"), ShouldBeNil)
+			So(OGRNIP("304500116000158"), ShouldEqual, ErrInvalidChecksum)
+			So(OGRNIP("1027700132195"), ShouldEqual, ErrInvalidLength)
+		})
+		c.Convey("It should check KPP", func(c C) {
+			So(KPP("773601001"), ShouldBeNil)
+			So(KPP("7736AB001"), ShouldBeNil)
+			So(KPP("7736ab001"), ShouldEqual, ErrInvalidFormat)
+			So(KPP("77360100"), ShouldEqual, ErrInvalidLength)
+		})
+		c.Convey("It should check SNILS", func(c C) {
+			So(SNILS("11223344595"), ShouldBeNil)
+			So(SNILS("112-233-445 95"), ShouldBeNil)
+			So(SNILS("11223344596"), ShouldEqual, ErrInvalidChecksum)
+			So(SNILS("00100199812"), ShouldBeNil)
+			So(SNILS("1122334459"), ShouldEqual, ErrInvalidLength)
+		})
+	})
+}